@@ -0,0 +1,4216 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: docksphinx/v1/docksphinx.proto
+
+package docksphinxv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Severity of an event, ordered from least to most severe
+type Severity int32
+
+const (
+	Severity_SEVERITY_UNSPECIFIED Severity = 0
+	Severity_SEVERITY_INFO        Severity = 1
+	Severity_SEVERITY_WARNING     Severity = 2
+	Severity_SEVERITY_CRITICAL    Severity = 3
+)
+
+// Enum value maps for Severity.
+var (
+	Severity_name = map[int32]string{
+		0: "SEVERITY_UNSPECIFIED",
+		1: "SEVERITY_INFO",
+		2: "SEVERITY_WARNING",
+		3: "SEVERITY_CRITICAL",
+	}
+	Severity_value = map[string]int32{
+		"SEVERITY_UNSPECIFIED": 0,
+		"SEVERITY_INFO":        1,
+		"SEVERITY_WARNING":     2,
+		"SEVERITY_CRITICAL":    3,
+	}
+)
+
+func (x Severity) Enum() *Severity {
+	p := new(Severity)
+	*p = x
+	return p
+}
+
+func (x Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_docksphinx_v1_docksphinx_proto_enumTypes[0].Descriptor()
+}
+
+func (Severity) Type() protoreflect.EnumType {
+	return &file_docksphinx_v1_docksphinx_proto_enumTypes[0]
+}
+
+func (x Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Severity.Descriptor instead.
+func (Severity) EnumDescriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{0}
+}
+
+type GetSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSnapshotRequest) Reset() {
+	*x = GetSnapshotRequest{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSnapshotRequest) ProtoMessage() {}
+
+func (x *GetSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{0}
+}
+
+type StreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Send the current snapshot before any event
+	IncludeInitialSnapshot bool `protobuf:"varint,1,opt,name=include_initial_snapshot,json=includeInitialSnapshot,proto3" json:"include_initial_snapshot,omitempty"`
+	// Also send a snapshot every N seconds (0 disables)
+	SnapshotIntervalSeconds int32 `protobuf:"varint,2,opt,name=snapshot_interval_seconds,json=snapshotIntervalSeconds,proto3" json:"snapshot_interval_seconds,omitempty"`
+	// Only send events at least this severe (unspecified sends all)
+	MinSeverity   Severity `protobuf:"varint,3,opt,name=min_severity,json=minSeverity,proto3,enum=docksphinx.v1.Severity" json:"min_severity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamRequest) Reset() {
+	*x = StreamRequest{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRequest) ProtoMessage() {}
+
+func (x *StreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRequest.ProtoReflect.Descriptor instead.
+func (*StreamRequest) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StreamRequest) GetIncludeInitialSnapshot() bool {
+	if x != nil {
+		return x.IncludeInitialSnapshot
+	}
+	return false
+}
+
+func (x *StreamRequest) GetSnapshotIntervalSeconds() int32 {
+	if x != nil {
+		return x.SnapshotIntervalSeconds
+	}
+	return 0
+}
+
+func (x *StreamRequest) GetMinSeverity() Severity {
+	if x != nil {
+		return x.MinSeverity
+	}
+	return Severity_SEVERITY_UNSPECIFIED
+}
+
+type StreamUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*StreamUpdate_Snapshot
+	//	*StreamUpdate_Event
+	Payload       isStreamUpdate_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamUpdate) Reset() {
+	*x = StreamUpdate{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamUpdate) ProtoMessage() {}
+
+func (x *StreamUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamUpdate.ProtoReflect.Descriptor instead.
+func (*StreamUpdate) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StreamUpdate) GetPayload() isStreamUpdate_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *StreamUpdate) GetSnapshot() *Snapshot {
+	if x != nil {
+		if x, ok := x.Payload.(*StreamUpdate_Snapshot); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+func (x *StreamUpdate) GetEvent() *Event {
+	if x != nil {
+		if x, ok := x.Payload.(*StreamUpdate_Event); ok {
+			return x.Event
+		}
+	}
+	return nil
+}
+
+type isStreamUpdate_Payload interface {
+	isStreamUpdate_Payload()
+}
+
+type StreamUpdate_Snapshot struct {
+	Snapshot *Snapshot `protobuf:"bytes,1,opt,name=snapshot,proto3,oneof"`
+}
+
+type StreamUpdate_Event struct {
+	Event *Event `protobuf:"bytes,2,opt,name=event,proto3,oneof"`
+}
+
+func (*StreamUpdate_Snapshot) isStreamUpdate_Payload() {}
+
+func (*StreamUpdate_Event) isStreamUpdate_Payload() {}
+
+type Snapshot struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Containers []*ContainerInfo       `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers,omitempty"`
+	// Key: container_id
+	Metrics  map[string]*ContainerMetrics `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AtUnix   int64                        `protobuf:"varint,3,opt,name=at_unix,json=atUnix,proto3" json:"at_unix,omitempty"`
+	Images   []*ImageInfo                 `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
+	Networks []*NetworkInfo               `protobuf:"bytes,5,rep,name=networks,proto3" json:"networks,omitempty"`
+	Volumes  []*VolumeInfo                `protobuf:"bytes,6,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	// Unset until disk usage has been collected
+	DiskUsage     *DiskUsage `protobuf:"bytes,7,opt,name=disk_usage,json=diskUsage,proto3" json:"disk_usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Snapshot) GetContainers() []*ContainerInfo {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+func (x *Snapshot) GetMetrics() map[string]*ContainerMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *Snapshot) GetAtUnix() int64 {
+	if x != nil {
+		return x.AtUnix
+	}
+	return 0
+}
+
+func (x *Snapshot) GetImages() []*ImageInfo {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Snapshot) GetNetworks() []*NetworkInfo {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *Snapshot) GetVolumes() []*VolumeInfo {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *Snapshot) GetDiskUsage() *DiskUsage {
+	if x != nil {
+		return x.DiskUsage
+	}
+	return nil
+}
+
+type ContainerInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ContainerName string                 `protobuf:"bytes,2,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	ImageName     string                 `protobuf:"bytes,3,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	LastSeenUnix  int64                  `protobuf:"varint,6,opt,name=last_seen_unix,json=lastSeenUnix,proto3" json:"last_seen_unix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerInfo) Reset() {
+	*x = ContainerInfo{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerInfo) ProtoMessage() {}
+
+func (x *ContainerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerInfo.ProtoReflect.Descriptor instead.
+func (*ContainerInfo) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ContainerInfo) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetImageName() string {
+	if x != nil {
+		return x.ImageName
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetLastSeenUnix() int64 {
+	if x != nil {
+		return x.LastSeenUnix
+	}
+	return 0
+}
+
+type ContainerMetrics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	CpuPercent    float64                `protobuf:"fixed64,2,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	MemoryUsage   int64                  `protobuf:"varint,3,opt,name=memory_usage,json=memoryUsage,proto3" json:"memory_usage,omitempty"`
+	MemoryLimit   int64                  `protobuf:"varint,4,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	MemoryPercent float64                `protobuf:"fixed64,5,opt,name=memory_percent,json=memoryPercent,proto3" json:"memory_percent,omitempty"`
+	NetworkRx     int64                  `protobuf:"varint,6,opt,name=network_rx,json=networkRx,proto3" json:"network_rx,omitempty"`
+	NetworkTx     int64                  `protobuf:"varint,7,opt,name=network_tx,json=networkTx,proto3" json:"network_tx,omitempty"`
+	// Writable layer size from the last disk usage collection
+	SizeRw        int64 `protobuf:"varint,8,opt,name=size_rw,json=sizeRw,proto3" json:"size_rw,omitempty"`
+	SizeRootFs    int64 `protobuf:"varint,9,opt,name=size_root_fs,json=sizeRootFs,proto3" json:"size_root_fs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerMetrics) Reset() {
+	*x = ContainerMetrics{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerMetrics) ProtoMessage() {}
+
+func (x *ContainerMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerMetrics.ProtoReflect.Descriptor instead.
+func (*ContainerMetrics) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ContainerMetrics) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ContainerMetrics) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *ContainerMetrics) GetMemoryUsage() int64 {
+	if x != nil {
+		return x.MemoryUsage
+	}
+	return 0
+}
+
+func (x *ContainerMetrics) GetMemoryLimit() int64 {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return 0
+}
+
+func (x *ContainerMetrics) GetMemoryPercent() float64 {
+	if x != nil {
+		return x.MemoryPercent
+	}
+	return 0
+}
+
+func (x *ContainerMetrics) GetNetworkRx() int64 {
+	if x != nil {
+		return x.NetworkRx
+	}
+	return 0
+}
+
+func (x *ContainerMetrics) GetNetworkTx() int64 {
+	if x != nil {
+		return x.NetworkTx
+	}
+	return 0
+}
+
+func (x *ContainerMetrics) GetSizeRw() int64 {
+	if x != nil {
+		return x.SizeRw
+	}
+	return 0
+}
+
+func (x *ContainerMetrics) GetSizeRootFs() int64 {
+	if x != nil {
+		return x.SizeRootFs
+	}
+	return 0
+}
+
+type ImageInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Repository    string                 `protobuf:"bytes,2,opt,name=repository,proto3" json:"repository,omitempty"`
+	Tag           string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedUnix   int64                  `protobuf:"varint,5,opt,name=created_unix,json=createdUnix,proto3" json:"created_unix,omitempty"`
+	VirtualSize   int64                  `protobuf:"varint,6,opt,name=virtual_size,json=virtualSize,proto3" json:"virtual_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageInfo) Reset() {
+	*x = ImageInfo{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageInfo) ProtoMessage() {}
+
+func (x *ImageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageInfo.ProtoReflect.Descriptor instead.
+func (*ImageInfo) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ImageInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImageInfo) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *ImageInfo) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ImageInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ImageInfo) GetCreatedUnix() int64 {
+	if x != nil {
+		return x.CreatedUnix
+	}
+	return 0
+}
+
+func (x *ImageInfo) GetVirtualSize() int64 {
+	if x != nil {
+		return x.VirtualSize
+	}
+	return 0
+}
+
+type NetworkInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Driver        string                 `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	Scope         string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	Internal      bool                   `protobuf:"varint,5,opt,name=internal,proto3" json:"internal,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkInfo) Reset() {
+	*x = NetworkInfo{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInfo) ProtoMessage() {}
+
+func (x *NetworkInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInfo.ProtoReflect.Descriptor instead.
+func (*NetworkInfo) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NetworkInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetInternal() bool {
+	if x != nil {
+		return x.Internal
+	}
+	return false
+}
+
+func (x *NetworkInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type VolumeInfo struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Driver     string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	Mountpoint string                 `protobuf:"bytes,3,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
+	Labels     map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// -1 if unknown (not collected yet or not reported by the driver)
+	Size int64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// Number of containers referencing the volume, -1 if unknown
+	RefCount      int64 `protobuf:"varint,6,opt,name=ref_count,json=refCount,proto3" json:"ref_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeInfo) Reset() {
+	*x = VolumeInfo{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeInfo) ProtoMessage() {}
+
+func (x *VolumeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeInfo.ProtoReflect.Descriptor instead.
+func (*VolumeInfo) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VolumeInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetMountpoint() string {
+	if x != nil {
+		return x.Mountpoint
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *VolumeInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *VolumeInfo) GetRefCount() int64 {
+	if x != nil {
+		return x.RefCount
+	}
+	return 0
+}
+
+type DiskUsage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LayersSize      int64                  `protobuf:"varint,1,opt,name=layers_size,json=layersSize,proto3" json:"layers_size,omitempty"`
+	ContainersSize  int64                  `protobuf:"varint,2,opt,name=containers_size,json=containersSize,proto3" json:"containers_size,omitempty"`
+	VolumesSize     int64                  `protobuf:"varint,3,opt,name=volumes_size,json=volumesSize,proto3" json:"volumes_size,omitempty"`
+	BuildCacheSize  int64                  `protobuf:"varint,4,opt,name=build_cache_size,json=buildCacheSize,proto3" json:"build_cache_size,omitempty"`
+	CollectedAtUnix int64                  `protobuf:"varint,5,opt,name=collected_at_unix,json=collectedAtUnix,proto3" json:"collected_at_unix,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DiskUsage) Reset() {
+	*x = DiskUsage{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskUsage) ProtoMessage() {}
+
+func (x *DiskUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskUsage.ProtoReflect.Descriptor instead.
+func (*DiskUsage) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DiskUsage) GetLayersSize() int64 {
+	if x != nil {
+		return x.LayersSize
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetContainersSize() int64 {
+	if x != nil {
+		return x.ContainersSize
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetVolumesSize() int64 {
+	if x != nil {
+		return x.VolumesSize
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetBuildCacheSize() int64 {
+	if x != nil {
+		return x.BuildCacheSize
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetCollectedAtUnix() int64 {
+	if x != nil {
+		return x.CollectedAtUnix
+	}
+	return 0
+}
+
+type ListImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListImagesRequest) Reset() {
+	*x = ListImagesRequest{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImagesRequest) ProtoMessage() {}
+
+func (x *ListImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImagesRequest.ProtoReflect.Descriptor instead.
+func (*ListImagesRequest) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{10}
+}
+
+type ListImagesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Images          []*ImageInfo           `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	CollectedAtUnix int64                  `protobuf:"varint,2,opt,name=collected_at_unix,json=collectedAtUnix,proto3" json:"collected_at_unix,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListImagesResponse) Reset() {
+	*x = ListImagesResponse{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImagesResponse) ProtoMessage() {}
+
+func (x *ListImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImagesResponse.ProtoReflect.Descriptor instead.
+func (*ListImagesResponse) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListImagesResponse) GetImages() []*ImageInfo {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *ListImagesResponse) GetCollectedAtUnix() int64 {
+	if x != nil {
+		return x.CollectedAtUnix
+	}
+	return 0
+}
+
+type ListNetworksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworksRequest) Reset() {
+	*x = ListNetworksRequest{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworksRequest) ProtoMessage() {}
+
+func (x *ListNetworksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworksRequest.ProtoReflect.Descriptor instead.
+func (*ListNetworksRequest) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{12}
+}
+
+type ListNetworksResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Networks        []*NetworkInfo         `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+	CollectedAtUnix int64                  `protobuf:"varint,2,opt,name=collected_at_unix,json=collectedAtUnix,proto3" json:"collected_at_unix,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListNetworksResponse) Reset() {
+	*x = ListNetworksResponse{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworksResponse) ProtoMessage() {}
+
+func (x *ListNetworksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworksResponse.ProtoReflect.Descriptor instead.
+func (*ListNetworksResponse) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListNetworksResponse) GetNetworks() []*NetworkInfo {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *ListNetworksResponse) GetCollectedAtUnix() int64 {
+	if x != nil {
+		return x.CollectedAtUnix
+	}
+	return 0
+}
+
+type ListVolumesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesRequest) Reset() {
+	*x = ListVolumesRequest{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesRequest) ProtoMessage() {}
+
+func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesRequest.ProtoReflect.Descriptor instead.
+func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{14}
+}
+
+type ListVolumesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Volumes         []*VolumeInfo          `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	CollectedAtUnix int64                  `protobuf:"varint,2,opt,name=collected_at_unix,json=collectedAtUnix,proto3" json:"collected_at_unix,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListVolumesResponse) Reset() {
+	*x = ListVolumesResponse{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesResponse) ProtoMessage() {}
+
+func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesResponse.ProtoReflect.Descriptor instead.
+func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListVolumesResponse) GetVolumes() []*VolumeInfo {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *ListVolumesResponse) GetCollectedAtUnix() int64 {
+	if x != nil {
+		return x.CollectedAtUnix
+	}
+	return 0
+}
+
+type GetDependencyGraphRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restrict the graph to the containers connected to this container (optional)
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Also render the graph as "dot" or "json" into DependencyGraph.rendered (optional)
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDependencyGraphRequest) Reset() {
+	*x = GetDependencyGraphRequest{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDependencyGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDependencyGraphRequest) ProtoMessage() {}
+
+func (x *GetDependencyGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDependencyGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetDependencyGraphRequest) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetDependencyGraphRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *GetDependencyGraphRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type DependencyGraph struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Nodes []*GraphNode           `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges []*GraphEdge           `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	// Set when GetDependencyGraphRequest.format is given
+	Rendered        string `protobuf:"bytes,3,opt,name=rendered,proto3" json:"rendered,omitempty"`
+	CollectedAtUnix int64  `protobuf:"varint,4,opt,name=collected_at_unix,json=collectedAtUnix,proto3" json:"collected_at_unix,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DependencyGraph) Reset() {
+	*x = DependencyGraph{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyGraph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyGraph) ProtoMessage() {}
+
+func (x *DependencyGraph) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyGraph.ProtoReflect.Descriptor instead.
+func (*DependencyGraph) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DependencyGraph) GetNodes() []*GraphNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *DependencyGraph) GetEdges() []*GraphEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *DependencyGraph) GetRendered() string {
+	if x != nil {
+		return x.Rendered
+	}
+	return ""
+}
+
+func (x *DependencyGraph) GetCollectedAtUnix() int64 {
+	if x != nil {
+		return x.CollectedAtUnix
+	}
+	return 0
+}
+
+type GraphNode struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId    string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ContainerName  string                 `protobuf:"bytes,2,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	ImageName      string                 `protobuf:"bytes,3,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`
+	State          string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	ComposeProject string                 `protobuf:"bytes,5,opt,name=compose_project,json=composeProject,proto3" json:"compose_project,omitempty"`
+	ComposeService string                 `protobuf:"bytes,6,opt,name=compose_service,json=composeService,proto3" json:"compose_service,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GraphNode) Reset() {
+	*x = GraphNode{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphNode) ProtoMessage() {}
+
+func (x *GraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphNode.ProtoReflect.Descriptor instead.
+func (*GraphNode) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GraphNode) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *GraphNode) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *GraphNode) GetImageName() string {
+	if x != nil {
+		return x.ImageName
+	}
+	return ""
+}
+
+func (x *GraphNode) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *GraphNode) GetComposeProject() string {
+	if x != nil {
+		return x.ComposeProject
+	}
+	return ""
+}
+
+func (x *GraphNode) GetComposeService() string {
+	if x != nil {
+		return x.ComposeService
+	}
+	return ""
+}
+
+type GraphEdge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Container IDs. For directed edges "from" depends on "to".
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// depends_on, link, network_mode, network or volume
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Network/volume name, depends_on condition or link alias
+	Detail        string `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	Directed      bool   `protobuf:"varint,5,opt,name=directed,proto3" json:"directed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraphEdge) Reset() {
+	*x = GraphEdge{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphEdge) ProtoMessage() {}
+
+func (x *GraphEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphEdge.ProtoReflect.Descriptor instead.
+func (*GraphEdge) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GraphEdge) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GraphEdge) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GraphEdge) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GraphEdge) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *GraphEdge) GetDirected() bool {
+	if x != nil {
+		return x.Directed
+	}
+	return false
+}
+
+type GetLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Container ID, ID prefix or name
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Number of lines from the end of the logs (0 means all lines)
+	Tail int32 `protobuf:"varint,2,opt,name=tail,proto3" json:"tail,omitempty"`
+	// Time range (0 means unbounded). until_unix is ignored by FollowLogs.
+	SinceUnix int64 `protobuf:"varint,3,opt,name=since_unix,json=sinceUnix,proto3" json:"since_unix,omitempty"`
+	UntilUnix int64 `protobuf:"varint,4,opt,name=until_unix,json=untilUnix,proto3" json:"until_unix,omitempty"`
+	// Stream selection; if both are false, both streams are returned
+	Stdout        bool `protobuf:"varint,5,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr        bool `protobuf:"varint,6,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLogsRequest) Reset() {
+	*x = GetLogsRequest{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogsRequest) ProtoMessage() {}
+
+func (x *GetLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetLogsRequest) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetLogsRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *GetLogsRequest) GetTail() int32 {
+	if x != nil {
+		return x.Tail
+	}
+	return 0
+}
+
+func (x *GetLogsRequest) GetSinceUnix() int64 {
+	if x != nil {
+		return x.SinceUnix
+	}
+	return 0
+}
+
+func (x *GetLogsRequest) GetUntilUnix() int64 {
+	if x != nil {
+		return x.UntilUnix
+	}
+	return 0
+}
+
+func (x *GetLogsRequest) GetStdout() bool {
+	if x != nil {
+		return x.Stdout
+	}
+	return false
+}
+
+func (x *GetLogsRequest) GetStderr() bool {
+	if x != nil {
+		return x.Stderr
+	}
+	return false
+}
+
+type GetLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*LogLine             `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLogsResponse) Reset() {
+	*x = GetLogsResponse{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogsResponse) ProtoMessage() {}
+
+func (x *GetLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetLogsResponse) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetLogsResponse) GetLines() []*LogLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type LogLine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "stdout" or "stderr"
+	Stream            string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	TimestampUnixNano int64  `protobuf:"varint,2,opt,name=timestamp_unix_nano,json=timestampUnixNano,proto3" json:"timestamp_unix_nano,omitempty"`
+	Text              string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LogLine) Reset() {
+	*x = LogLine{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLine) ProtoMessage() {}
+
+func (x *LogLine) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLine.ProtoReflect.Descriptor instead.
+func (*LogLine) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *LogLine) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *LogLine) GetTimestampUnixNano() int64 {
+	if x != nil {
+		return x.TimestampUnixNano
+	}
+	return 0
+}
+
+func (x *LogLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	TimestampUnix int64                  `protobuf:"varint,3,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ContainerName string                 `protobuf:"bytes,5,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	ImageName     string                 `protobuf:"bytes,6,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Data          map[string]string      `protobuf:"bytes,8,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Last log lines captured when the event was generated (e.g. died)
+	Logs     []*LogLine `protobuf:"bytes,9,rep,name=logs,proto3" json:"logs,omitempty"`
+	Severity Severity   `protobuf:"varint,10,opt,name=severity,proto3,enum=docksphinx.v1.Severity" json:"severity,omitempty"`
+	// Typed event-specific data; data carries the same values as strings
+	//
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Event_StateChange
+	//	*Event_Threshold
+	//	*Event_VolumeGrowth
+	//	*Event_LogMatch
+	//	*Event_Impact
+	//	*Event_Group
+	//	*Event_DockerConnection
+	//	*Event_CrashLoop
+	Payload       isEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetTimestampUnix() int64 {
+	if x != nil {
+		return x.TimestampUnix
+	}
+	return 0
+}
+
+func (x *Event) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *Event) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *Event) GetImageName() string {
+	if x != nil {
+		return x.ImageName
+	}
+	return ""
+}
+
+func (x *Event) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Event) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Event) GetLogs() []*LogLine {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *Event) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_SEVERITY_UNSPECIFIED
+}
+
+func (x *Event) GetPayload() isEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetStateChange() *StateChangePayload {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_StateChange); ok {
+			return x.StateChange
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetThreshold() *ThresholdPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_Threshold); ok {
+			return x.Threshold
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetVolumeGrowth() *VolumeGrowthPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_VolumeGrowth); ok {
+			return x.VolumeGrowth
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetLogMatch() *LogMatchPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_LogMatch); ok {
+			return x.LogMatch
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetImpact() *ImpactPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_Impact); ok {
+			return x.Impact
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetGroup() *GroupPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_Group); ok {
+			return x.Group
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetDockerConnection() *DockerConnectionPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_DockerConnection); ok {
+			return x.DockerConnection
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetCrashLoop() *CrashLoopPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_CrashLoop); ok {
+			return x.CrashLoop
+		}
+	}
+	return nil
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_StateChange struct {
+	StateChange *StateChangePayload `protobuf:"bytes,11,opt,name=state_change,json=stateChange,proto3,oneof"`
+}
+
+type Event_Threshold struct {
+	Threshold *ThresholdPayload `protobuf:"bytes,12,opt,name=threshold,proto3,oneof"`
+}
+
+type Event_VolumeGrowth struct {
+	VolumeGrowth *VolumeGrowthPayload `protobuf:"bytes,13,opt,name=volume_growth,json=volumeGrowth,proto3,oneof"`
+}
+
+type Event_LogMatch struct {
+	LogMatch *LogMatchPayload `protobuf:"bytes,14,opt,name=log_match,json=logMatch,proto3,oneof"`
+}
+
+type Event_Impact struct {
+	Impact *ImpactPayload `protobuf:"bytes,15,opt,name=impact,proto3,oneof"`
+}
+
+type Event_Group struct {
+	Group *GroupPayload `protobuf:"bytes,16,opt,name=group,proto3,oneof"`
+}
+
+type Event_DockerConnection struct {
+	DockerConnection *DockerConnectionPayload `protobuf:"bytes,17,opt,name=docker_connection,json=dockerConnection,proto3,oneof"`
+}
+
+type Event_CrashLoop struct {
+	CrashLoop *CrashLoopPayload `protobuf:"bytes,18,opt,name=crash_loop,json=crashLoop,proto3,oneof"`
+}
+
+func (*Event_StateChange) isEvent_Payload() {}
+
+func (*Event_Threshold) isEvent_Payload() {}
+
+func (*Event_VolumeGrowth) isEvent_Payload() {}
+
+func (*Event_LogMatch) isEvent_Payload() {}
+
+func (*Event_Impact) isEvent_Payload() {}
+
+func (*Event_Group) isEvent_Payload() {}
+
+func (*Event_DockerConnection) isEvent_Payload() {}
+
+func (*Event_CrashLoop) isEvent_Payload() {}
+
+// Payload of started, stopped, restarted, died and oom_killed events
+type StateChangePayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PreviousState string                 `protobuf:"bytes,1,opt,name=previous_state,json=previousState,proto3" json:"previous_state,omitempty"`
+	// Only for restarted events
+	TimeSinceStopSeconds float64 `protobuf:"fixed64,2,opt,name=time_since_stop_seconds,json=timeSinceStopSeconds,proto3" json:"time_since_stop_seconds,omitempty"`
+	// Containers depending on the container (only for stopped and died events)
+	Dependents   []string `protobuf:"bytes,3,rep,name=dependents,proto3" json:"dependents,omitempty"`
+	DependentIds []string `protobuf:"bytes,4,rep,name=dependent_ids,json=dependentIds,proto3" json:"dependent_ids,omitempty"`
+	// Only for stopped, died and oom_killed events (0 if unknown)
+	ExitCode      int32 `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateChangePayload) Reset() {
+	*x = StateChangePayload{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateChangePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateChangePayload) ProtoMessage() {}
+
+func (x *StateChangePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateChangePayload.ProtoReflect.Descriptor instead.
+func (*StateChangePayload) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StateChangePayload) GetPreviousState() string {
+	if x != nil {
+		return x.PreviousState
+	}
+	return ""
+}
+
+func (x *StateChangePayload) GetTimeSinceStopSeconds() float64 {
+	if x != nil {
+		return x.TimeSinceStopSeconds
+	}
+	return 0
+}
+
+func (x *StateChangePayload) GetDependents() []string {
+	if x != nil {
+		return x.Dependents
+	}
+	return nil
+}
+
+func (x *StateChangePayload) GetDependentIds() []string {
+	if x != nil {
+		return x.DependentIds
+	}
+	return nil
+}
+
+func (x *StateChangePayload) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+// Payload of crash_loop events
+type CrashLoopPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Crashes within the window
+	Crashes       int32   `protobuf:"varint,1,opt,name=crashes,proto3" json:"crashes,omitempty"`
+	WindowSeconds float64 `protobuf:"fixed64,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// Exit code of the last crash (0 if unknown)
+	ExitCode      int32 `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrashLoopPayload) Reset() {
+	*x = CrashLoopPayload{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrashLoopPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashLoopPayload) ProtoMessage() {}
+
+func (x *CrashLoopPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashLoopPayload.ProtoReflect.Descriptor instead.
+func (*CrashLoopPayload) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CrashLoopPayload) GetCrashes() int32 {
+	if x != nil {
+		return x.Crashes
+	}
+	return 0
+}
+
+func (x *CrashLoopPayload) GetWindowSeconds() float64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *CrashLoopPayload) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+// Payload of cpu_threshold and mem_threshold events
+type ThresholdPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "cpu" or "memory"
+	Metric string `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	// Usage in percent
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Exceeded threshold in percent
+	Threshold        float64 `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ConsecutiveCount int32   `protobuf:"varint,4,opt,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ThresholdPayload) Reset() {
+	*x = ThresholdPayload{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThresholdPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdPayload) ProtoMessage() {}
+
+func (x *ThresholdPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdPayload.ProtoReflect.Descriptor instead.
+func (*ThresholdPayload) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ThresholdPayload) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *ThresholdPayload) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ThresholdPayload) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *ThresholdPayload) GetConsecutiveCount() int32 {
+	if x != nil {
+		return x.ConsecutiveCount
+	}
+	return 0
+}
+
+// Payload of volume_growth events
+type VolumeGrowthPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VolumeName    string                 `protobuf:"bytes,1,opt,name=volume_name,json=volumeName,proto3" json:"volume_name,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	PreviousSize  int64                  `protobuf:"varint,3,opt,name=previous_size,json=previousSize,proto3" json:"previous_size,omitempty"`
+	GrowthBytes   int64                  `protobuf:"varint,4,opt,name=growth_bytes,json=growthBytes,proto3" json:"growth_bytes,omitempty"`
+	GrowthPercent float64                `protobuf:"fixed64,5,opt,name=growth_percent,json=growthPercent,proto3" json:"growth_percent,omitempty"`
+	// -1 if unknown
+	RefCount      int64 `protobuf:"varint,6,opt,name=ref_count,json=refCount,proto3" json:"ref_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeGrowthPayload) Reset() {
+	*x = VolumeGrowthPayload{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeGrowthPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeGrowthPayload) ProtoMessage() {}
+
+func (x *VolumeGrowthPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeGrowthPayload.ProtoReflect.Descriptor instead.
+func (*VolumeGrowthPayload) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *VolumeGrowthPayload) GetVolumeName() string {
+	if x != nil {
+		return x.VolumeName
+	}
+	return ""
+}
+
+func (x *VolumeGrowthPayload) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *VolumeGrowthPayload) GetPreviousSize() int64 {
+	if x != nil {
+		return x.PreviousSize
+	}
+	return 0
+}
+
+func (x *VolumeGrowthPayload) GetGrowthBytes() int64 {
+	if x != nil {
+		return x.GrowthBytes
+	}
+	return 0
+}
+
+func (x *VolumeGrowthPayload) GetGrowthPercent() float64 {
+	if x != nil {
+		return x.GrowthPercent
+	}
+	return 0
+}
+
+func (x *VolumeGrowthPayload) GetRefCount() int64 {
+	if x != nil {
+		return x.RefCount
+	}
+	return 0
+}
+
+// Payload of log_match events
+type LogMatchPayload struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Pattern    string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Line       string                 `protobuf:"bytes,2,opt,name=line,proto3" json:"line,omitempty"`
+	Stream     string                 `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+	MatchCount int32                  `protobuf:"varint,4,opt,name=match_count,json=matchCount,proto3" json:"match_count,omitempty"`
+	// 0 without rate detection
+	WindowSeconds float64 `protobuf:"fixed64,5,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	Suppressed    int32   `protobuf:"varint,6,opt,name=suppressed,proto3" json:"suppressed,omitempty"`
+	// Lines around the match, including the matching line
+	Context       []string `protobuf:"bytes,7,rep,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogMatchPayload) Reset() {
+	*x = LogMatchPayload{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogMatchPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogMatchPayload) ProtoMessage() {}
+
+func (x *LogMatchPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogMatchPayload.ProtoReflect.Descriptor instead.
+func (*LogMatchPayload) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LogMatchPayload) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *LogMatchPayload) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *LogMatchPayload) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *LogMatchPayload) GetMatchCount() int32 {
+	if x != nil {
+		return x.MatchCount
+	}
+	return 0
+}
+
+func (x *LogMatchPayload) GetWindowSeconds() float64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *LogMatchPayload) GetSuppressed() int32 {
+	if x != nil {
+		return x.Suppressed
+	}
+	return 0
+}
+
+func (x *LogMatchPayload) GetContext() []string {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+// Payload of impact events
+type ImpactPayload struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RootCauseFailedAtUnix int64                  `protobuf:"varint,1,opt,name=root_cause_failed_at_unix,json=rootCauseFailedAtUnix,proto3" json:"root_cause_failed_at_unix,omitempty"`
+	Affected              []string               `protobuf:"bytes,2,rep,name=affected,proto3" json:"affected,omitempty"`
+	AffectedIds           []string               `protobuf:"bytes,3,rep,name=affected_ids,json=affectedIds,proto3" json:"affected_ids,omitempty"`
+	WindowSeconds         float64                `protobuf:"fixed64,4,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ImpactPayload) Reset() {
+	*x = ImpactPayload{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpactPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpactPayload) ProtoMessage() {}
+
+func (x *ImpactPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpactPayload.ProtoReflect.Descriptor instead.
+func (*ImpactPayload) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ImpactPayload) GetRootCauseFailedAtUnix() int64 {
+	if x != nil {
+		return x.RootCauseFailedAtUnix
+	}
+	return 0
+}
+
+func (x *ImpactPayload) GetAffected() []string {
+	if x != nil {
+		return x.Affected
+	}
+	return nil
+}
+
+func (x *ImpactPayload) GetAffectedIds() []string {
+	if x != nil {
+		return x.AffectedIds
+	}
+	return nil
+}
+
+func (x *ImpactPayload) GetWindowSeconds() float64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+// Payload of an alert summarizing the events of a compose project or container
+type GroupPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Containers    []string               `protobuf:"bytes,3,rep,name=containers,proto3" json:"containers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupPayload) Reset() {
+	*x = GroupPayload{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupPayload) ProtoMessage() {}
+
+func (x *GroupPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupPayload.ProtoReflect.Descriptor instead.
+func (*GroupPayload) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GroupPayload) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *GroupPayload) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GroupPayload) GetContainers() []string {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+// Payload of docker_disconnected and docker_reconnected events
+type DockerConnectionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Why the daemon is unreachable (only for docker_disconnected)
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// Time the daemon was unreachable (only for docker_reconnected)
+	DowntimeSeconds float64 `protobuf:"fixed64,2,opt,name=downtime_seconds,json=downtimeSeconds,proto3" json:"downtime_seconds,omitempty"`
+	// Failed collections before reconnecting (only for docker_reconnected)
+	Attempts int32 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Containers marked unknown, or resynced on reconnect
+	ContainerCount int32 `protobuf:"varint,4,opt,name=container_count,json=containerCount,proto3" json:"container_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DockerConnectionPayload) Reset() {
+	*x = DockerConnectionPayload{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DockerConnectionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DockerConnectionPayload) ProtoMessage() {}
+
+func (x *DockerConnectionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DockerConnectionPayload.ProtoReflect.Descriptor instead.
+func (*DockerConnectionPayload) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DockerConnectionPayload) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DockerConnectionPayload) GetDowntimeSeconds() float64 {
+	if x != nil {
+		return x.DowntimeSeconds
+	}
+	return 0
+}
+
+func (x *DockerConnectionPayload) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DockerConnectionPayload) GetContainerCount() int32 {
+	if x != nil {
+		return x.ContainerCount
+	}
+	return 0
+}
+
+type GetEventHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Container ID or name (optional)
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Event types (optional)
+	Types     []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	SinceUnix int64    `protobuf:"varint,3,opt,name=since_unix,json=sinceUnix,proto3" json:"since_unix,omitempty"`
+	// Maximum number of most recent events (0 means all)
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Only events at least this severe (unspecified returns all)
+	MinSeverity   Severity `protobuf:"varint,5,opt,name=min_severity,json=minSeverity,proto3,enum=docksphinx.v1.Severity" json:"min_severity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventHistoryRequest) Reset() {
+	*x = GetEventHistoryRequest{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventHistoryRequest) ProtoMessage() {}
+
+func (x *GetEventHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetEventHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetEventHistoryRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *GetEventHistoryRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *GetEventHistoryRequest) GetSinceUnix() int64 {
+	if x != nil {
+		return x.SinceUnix
+	}
+	return 0
+}
+
+func (x *GetEventHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetEventHistoryRequest) GetMinSeverity() Severity {
+	if x != nil {
+		return x.MinSeverity
+	}
+	return Severity_SEVERITY_UNSPECIFIED
+}
+
+type GetEventHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Oldest first
+	Events        []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventHistoryResponse) Reset() {
+	*x = GetEventHistoryResponse{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventHistoryResponse) ProtoMessage() {}
+
+func (x *GetEventHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetEventHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetEventHistoryResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetMetricHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Container ID or name (optional, all containers if empty)
+	ContainerId   string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetricHistoryRequest) Reset() {
+	*x = GetMetricHistoryRequest{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetricHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetricHistoryRequest) ProtoMessage() {}
+
+func (x *GetMetricHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetricHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMetricHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetMetricHistoryRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+type GetMetricHistoryResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Histories     []*ContainerMetricHistory `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetricHistoryResponse) Reset() {
+	*x = GetMetricHistoryResponse{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetricHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetricHistoryResponse) ProtoMessage() {}
+
+func (x *GetMetricHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetricHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMetricHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetMetricHistoryResponse) GetHistories() []*ContainerMetricHistory {
+	if x != nil {
+		return x.Histories
+	}
+	return nil
+}
+
+type ContainerMetricHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ContainerName string                 `protobuf:"bytes,2,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	// Oldest first
+	Samples       []*MetricSample `protobuf:"bytes,3,rep,name=samples,proto3" json:"samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerMetricHistory) Reset() {
+	*x = ContainerMetricHistory{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerMetricHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerMetricHistory) ProtoMessage() {}
+
+func (x *ContainerMetricHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerMetricHistory.ProtoReflect.Descriptor instead.
+func (*ContainerMetricHistory) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ContainerMetricHistory) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ContainerMetricHistory) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *ContainerMetricHistory) GetSamples() []*MetricSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type MetricSample struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimestampUnix int64                  `protobuf:"varint,1,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	CpuPercent    float64                `protobuf:"fixed64,2,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	MemoryUsage   int64                  `protobuf:"varint,3,opt,name=memory_usage,json=memoryUsage,proto3" json:"memory_usage,omitempty"`
+	MemoryPercent float64                `protobuf:"fixed64,4,opt,name=memory_percent,json=memoryPercent,proto3" json:"memory_percent,omitempty"`
+	// Bytes per second since the previous sample
+	NetworkRxRate  float64 `protobuf:"fixed64,5,opt,name=network_rx_rate,json=networkRxRate,proto3" json:"network_rx_rate,omitempty"`
+	NetworkTxRate  float64 `protobuf:"fixed64,6,opt,name=network_tx_rate,json=networkTxRate,proto3" json:"network_tx_rate,omitempty"`
+	BlockReadRate  float64 `protobuf:"fixed64,7,opt,name=block_read_rate,json=blockReadRate,proto3" json:"block_read_rate,omitempty"`
+	BlockWriteRate float64 `protobuf:"fixed64,8,opt,name=block_write_rate,json=blockWriteRate,proto3" json:"block_write_rate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MetricSample) Reset() {
+	*x = MetricSample{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricSample) ProtoMessage() {}
+
+func (x *MetricSample) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricSample.ProtoReflect.Descriptor instead.
+func (*MetricSample) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MetricSample) GetTimestampUnix() int64 {
+	if x != nil {
+		return x.TimestampUnix
+	}
+	return 0
+}
+
+func (x *MetricSample) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *MetricSample) GetMemoryUsage() int64 {
+	if x != nil {
+		return x.MemoryUsage
+	}
+	return 0
+}
+
+func (x *MetricSample) GetMemoryPercent() float64 {
+	if x != nil {
+		return x.MemoryPercent
+	}
+	return 0
+}
+
+func (x *MetricSample) GetNetworkRxRate() float64 {
+	if x != nil {
+		return x.NetworkRxRate
+	}
+	return 0
+}
+
+func (x *MetricSample) GetNetworkTxRate() float64 {
+	if x != nil {
+		return x.NetworkTxRate
+	}
+	return 0
+}
+
+func (x *MetricSample) GetBlockReadRate() float64 {
+	if x != nil {
+		return x.BlockReadRate
+	}
+	return 0
+}
+
+func (x *MetricSample) GetBlockWriteRate() float64 {
+	if x != nil {
+		return x.BlockWriteRate
+	}
+	return 0
+}
+
+type QueryMetricsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Container ID or name (optional, all containers if empty)
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// cpu_percent, memory_usage, memory_percent, network_rx_rate, network_tx_rate,
+	// block_read_rate or block_write_rate (optional, all metrics if empty)
+	Metrics   []string `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	StartUnix int64    `protobuf:"varint,3,opt,name=start_unix,json=startUnix,proto3" json:"start_unix,omitempty"`
+	// 0 means now
+	EndUnix int64 `protobuf:"varint,4,opt,name=end_unix,json=endUnix,proto3" json:"end_unix,omitempty"`
+	// Width of the returned points (0 uses the resolution the data is read from)
+	StepSeconds   int64 `protobuf:"varint,5,opt,name=step_seconds,json=stepSeconds,proto3" json:"step_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryMetricsRequest) Reset() {
+	*x = QueryMetricsRequest{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMetricsRequest) ProtoMessage() {}
+
+func (x *QueryMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryMetricsRequest.ProtoReflect.Descriptor instead.
+func (*QueryMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *QueryMetricsRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *QueryMetricsRequest) GetMetrics() []string {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *QueryMetricsRequest) GetStartUnix() int64 {
+	if x != nil {
+		return x.StartUnix
+	}
+	return 0
+}
+
+func (x *QueryMetricsRequest) GetEndUnix() int64 {
+	if x != nil {
+		return x.EndUnix
+	}
+	return 0
+}
+
+func (x *QueryMetricsRequest) GetStepSeconds() int64 {
+	if x != nil {
+		return x.StepSeconds
+	}
+	return 0
+}
+
+type QueryMetricsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Resolution the data was read from: raw, 1m, 5m or 1h
+	Resolution string `protobuf:"bytes,1,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	// Width of the points, 0 for raw samples
+	StepSeconds   int64           `protobuf:"varint,2,opt,name=step_seconds,json=stepSeconds,proto3" json:"step_seconds,omitempty"`
+	Series        []*MetricSeries `protobuf:"bytes,3,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryMetricsResponse) Reset() {
+	*x = QueryMetricsResponse{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMetricsResponse) ProtoMessage() {}
+
+func (x *QueryMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryMetricsResponse.ProtoReflect.Descriptor instead.
+func (*QueryMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *QueryMetricsResponse) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *QueryMetricsResponse) GetStepSeconds() int64 {
+	if x != nil {
+		return x.StepSeconds
+	}
+	return 0
+}
+
+func (x *QueryMetricsResponse) GetSeries() []*MetricSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type MetricSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ContainerName string                 `protobuf:"bytes,2,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	Metric        string                 `protobuf:"bytes,3,opt,name=metric,proto3" json:"metric,omitempty"`
+	// Oldest first
+	Points        []*MetricPoint `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetricSeries) Reset() {
+	*x = MetricSeries{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricSeries) ProtoMessage() {}
+
+func (x *MetricSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricSeries.ProtoReflect.Descriptor instead.
+func (*MetricSeries) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MetricSeries) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *MetricSeries) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *MetricSeries) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *MetricSeries) GetPoints() []*MetricPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type MetricPoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start of the step
+	TimestampUnix int64   `protobuf:"varint,1,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	Min           float64 `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64 `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	Avg           float64 `protobuf:"fixed64,4,opt,name=avg,proto3" json:"avg,omitempty"`
+	// Number of raw samples aggregated
+	Count         int64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetricPoint) Reset() {
+	*x = MetricPoint{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricPoint) ProtoMessage() {}
+
+func (x *MetricPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricPoint.ProtoReflect.Descriptor instead.
+func (*MetricPoint) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MetricPoint) GetTimestampUnix() int64 {
+	if x != nil {
+		return x.TimestampUnix
+	}
+	return 0
+}
+
+func (x *MetricPoint) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *MetricPoint) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *MetricPoint) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *MetricPoint) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Silence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Regex matched against the container name (optional)
+	Container string `protobuf:"bytes,2,opt,name=container,proto3" json:"container,omitempty"`
+	// Container labels that must all be equal (optional)
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Event types (optional)
+	EventTypes    []string `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Comment       string   `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAtUnix int64    `protobuf:"varint,6,opt,name=created_at_unix,json=createdAtUnix,proto3" json:"created_at_unix,omitempty"`
+	ExpiresAtUnix int64    `protobuf:"varint,7,opt,name=expires_at_unix,json=expiresAtUnix,proto3" json:"expires_at_unix,omitempty"`
+	// Number of events suppressed by the silence
+	Suppressed    uint64 `protobuf:"varint,8,opt,name=suppressed,proto3" json:"suppressed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Silence) Reset() {
+	*x = Silence{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Silence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Silence) ProtoMessage() {}
+
+func (x *Silence) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Silence.ProtoReflect.Descriptor instead.
+func (*Silence) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Silence) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Silence) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *Silence) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Silence) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Silence) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Silence) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *Silence) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *Silence) GetSuppressed() uint64 {
+	if x != nil {
+		return x.Suppressed
+	}
+	return 0
+}
+
+type CreateSilenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At least one of container, labels and event_types is required
+	Container       string            `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	Labels          map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	EventTypes      []string          `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Comment         string            `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	DurationSeconds int64             `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateSilenceRequest) Reset() {
+	*x = CreateSilenceRequest{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSilenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSilenceRequest) ProtoMessage() {}
+
+func (x *CreateSilenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSilenceRequest.ProtoReflect.Descriptor instead.
+func (*CreateSilenceRequest) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateSilenceRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *CreateSilenceRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateSilenceRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateSilenceRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CreateSilenceRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type ListSilencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Include silences that expired within the last day
+	IncludeExpired bool `protobuf:"varint,1,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	// Include recently suppressed events
+	IncludeSuppressed bool `protobuf:"varint,2,opt,name=include_suppressed,json=includeSuppressed,proto3" json:"include_suppressed,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListSilencesRequest) Reset() {
+	*x = ListSilencesRequest{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSilencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSilencesRequest) ProtoMessage() {}
+
+func (x *ListSilencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSilencesRequest.ProtoReflect.Descriptor instead.
+func (*ListSilencesRequest) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListSilencesRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+func (x *ListSilencesRequest) GetIncludeSuppressed() bool {
+	if x != nil {
+		return x.IncludeSuppressed
+	}
+	return false
+}
+
+type ListSilencesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first
+	Silences []*Silence `protobuf:"bytes,1,rep,name=silences,proto3" json:"silences,omitempty"`
+	// Oldest first
+	Suppressed    []*SuppressedEvent `protobuf:"bytes,2,rep,name=suppressed,proto3" json:"suppressed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSilencesResponse) Reset() {
+	*x = ListSilencesResponse{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSilencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSilencesResponse) ProtoMessage() {}
+
+func (x *ListSilencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSilencesResponse.ProtoReflect.Descriptor instead.
+func (*ListSilencesResponse) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListSilencesResponse) GetSilences() []*Silence {
+	if x != nil {
+		return x.Silences
+	}
+	return nil
+}
+
+func (x *ListSilencesResponse) GetSuppressed() []*SuppressedEvent {
+	if x != nil {
+		return x.Suppressed
+	}
+	return nil
+}
+
+type SuppressedEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// silenced or duplicate
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Silence that suppressed the event (for reason silenced)
+	SilenceId        string `protobuf:"bytes,3,opt,name=silence_id,json=silenceId,proto3" json:"silence_id,omitempty"`
+	SuppressedAtUnix int64  `protobuf:"varint,4,opt,name=suppressed_at_unix,json=suppressedAtUnix,proto3" json:"suppressed_at_unix,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SuppressedEvent) Reset() {
+	*x = SuppressedEvent{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuppressedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuppressedEvent) ProtoMessage() {}
+
+func (x *SuppressedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuppressedEvent.ProtoReflect.Descriptor instead.
+func (*SuppressedEvent) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SuppressedEvent) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *SuppressedEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SuppressedEvent) GetSilenceId() string {
+	if x != nil {
+		return x.SilenceId
+	}
+	return ""
+}
+
+func (x *SuppressedEvent) GetSuppressedAtUnix() int64 {
+	if x != nil {
+		return x.SuppressedAtUnix
+	}
+	return 0
+}
+
+type GetDaemonStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDaemonStatusRequest) Reset() {
+	*x = GetDaemonStatusRequest{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDaemonStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDaemonStatusRequest) ProtoMessage() {}
+
+func (x *GetDaemonStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDaemonStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetDaemonStatusRequest) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{47}
+}
+
+type DaemonStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	StartedAtUnix int64                  `protobuf:"varint,2,opt,name=started_at_unix,json=startedAtUnix,proto3" json:"started_at_unix,omitempty"`
+	UptimeSeconds int64                  `protobuf:"varint,3,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	// Configuration file the daemon loaded (empty: built-in defaults)
+	ConfigPath string `protobuf:"bytes,4,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"`
+	// Serving only when the last container collection succeeded recently
+	Healthy bool `protobuf:"varint,5,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// Why the daemon is unhealthy (empty if healthy)
+	HealthMessage                 string  `protobuf:"bytes,6,opt,name=health_message,json=healthMessage,proto3" json:"health_message,omitempty"`
+	LastCollectionUnix            int64   `protobuf:"varint,7,opt,name=last_collection_unix,json=lastCollectionUnix,proto3" json:"last_collection_unix,omitempty"`
+	LastCollectionDurationSeconds float64 `protobuf:"fixed64,8,opt,name=last_collection_duration_seconds,json=lastCollectionDurationSeconds,proto3" json:"last_collection_duration_seconds,omitempty"`
+	// Error of the last collection (empty if it succeeded)
+	LastCollectionError string `protobuf:"bytes,9,opt,name=last_collection_error,json=lastCollectionError,proto3" json:"last_collection_error,omitempty"`
+	TrackedContainers   int32  `protobuf:"varint,10,opt,name=tracked_containers,json=trackedContainers,proto3" json:"tracked_containers,omitempty"`
+	Subscribers         int32  `protobuf:"varint,11,opt,name=subscribers,proto3" json:"subscribers,omitempty"`
+	// Events dropped because the engine's event channel was full
+	DroppedEvents uint64 `protobuf:"varint,12,opt,name=dropped_events,json=droppedEvents,proto3" json:"dropped_events,omitempty"`
+	// Events not delivered to slow Stream subscribers
+	DroppedSubscriberEvents uint64 `protobuf:"varint,13,opt,name=dropped_subscriber_events,json=droppedSubscriberEvents,proto3" json:"dropped_subscriber_events,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *DaemonStatus) Reset() {
+	*x = DaemonStatus{}
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaemonStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaemonStatus) ProtoMessage() {}
+
+func (x *DaemonStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_docksphinx_v1_docksphinx_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaemonStatus.ProtoReflect.Descriptor instead.
+func (*DaemonStatus) Descriptor() ([]byte, []int) {
+	return file_docksphinx_v1_docksphinx_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DaemonStatus) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *DaemonStatus) GetStartedAtUnix() int64 {
+	if x != nil {
+		return x.StartedAtUnix
+	}
+	return 0
+}
+
+func (x *DaemonStatus) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *DaemonStatus) GetConfigPath() string {
+	if x != nil {
+		return x.ConfigPath
+	}
+	return ""
+}
+
+func (x *DaemonStatus) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *DaemonStatus) GetHealthMessage() string {
+	if x != nil {
+		return x.HealthMessage
+	}
+	return ""
+}
+
+func (x *DaemonStatus) GetLastCollectionUnix() int64 {
+	if x != nil {
+		return x.LastCollectionUnix
+	}
+	return 0
+}
+
+func (x *DaemonStatus) GetLastCollectionDurationSeconds() float64 {
+	if x != nil {
+		return x.LastCollectionDurationSeconds
+	}
+	return 0
+}
+
+func (x *DaemonStatus) GetLastCollectionError() string {
+	if x != nil {
+		return x.LastCollectionError
+	}
+	return ""
+}
+
+func (x *DaemonStatus) GetTrackedContainers() int32 {
+	if x != nil {
+		return x.TrackedContainers
+	}
+	return 0
+}
+
+func (x *DaemonStatus) GetSubscribers() int32 {
+	if x != nil {
+		return x.Subscribers
+	}
+	return 0
+}
+
+func (x *DaemonStatus) GetDroppedEvents() uint64 {
+	if x != nil {
+		return x.DroppedEvents
+	}
+	return 0
+}
+
+func (x *DaemonStatus) GetDroppedSubscriberEvents() uint64 {
+	if x != nil {
+		return x.DroppedSubscriberEvents
+	}
+	return 0
+}
+
+var File_docksphinx_v1_docksphinx_proto protoreflect.FileDescriptor
+
+const file_docksphinx_v1_docksphinx_proto_rawDesc = "" +
+	"\n" +
+	"\x1edocksphinx/v1/docksphinx.proto\x12\rdocksphinx.v1\"\x14\n" +
+	"\x12GetSnapshotRequest\"\xc1\x01\n" +
+	"\rStreamRequest\x128\n" +
+	"\x18include_initial_snapshot\x18\x01 \x01(\bR\x16includeInitialSnapshot\x12:\n" +
+	"\x19snapshot_interval_seconds\x18\x02 \x01(\x05R\x17snapshotIntervalSeconds\x12:\n" +
+	"\fmin_severity\x18\x03 \x01(\x0e2\x17.docksphinx.v1.SeverityR\vminSeverity\"~\n" +
+	"\fStreamUpdate\x125\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x17.docksphinx.v1.SnapshotH\x00R\bsnapshot\x12,\n" +
+	"\x05event\x18\x02 \x01(\v2\x14.docksphinx.v1.EventH\x00R\x05eventB\t\n" +
+	"\apayload\"\xd6\x03\n" +
+	"\bSnapshot\x12<\n" +
+	"\n" +
+	"containers\x18\x01 \x03(\v2\x1c.docksphinx.v1.ContainerInfoR\n" +
+	"containers\x12>\n" +
+	"\ametrics\x18\x02 \x03(\v2$.docksphinx.v1.Snapshot.MetricsEntryR\ametrics\x12\x17\n" +
+	"\aat_unix\x18\x03 \x01(\x03R\x06atUnix\x120\n" +
+	"\x06images\x18\x04 \x03(\v2\x18.docksphinx.v1.ImageInfoR\x06images\x126\n" +
+	"\bnetworks\x18\x05 \x03(\v2\x1a.docksphinx.v1.NetworkInfoR\bnetworks\x123\n" +
+	"\avolumes\x18\x06 \x03(\v2\x19.docksphinx.v1.VolumeInfoR\avolumes\x127\n" +
+	"\n" +
+	"disk_usage\x18\a \x01(\v2\x18.docksphinx.v1.DiskUsageR\tdiskUsage\x1a[\n" +
+	"\fMetricsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x125\n" +
+	"\x05value\x18\x02 \x01(\v2\x1f.docksphinx.v1.ContainerMetricsR\x05value:\x028\x01\"\xcc\x01\n" +
+	"\rContainerInfo\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12%\n" +
+	"\x0econtainer_name\x18\x02 \x01(\tR\rcontainerName\x12\x1d\n" +
+	"\n" +
+	"image_name\x18\x03 \x01(\tR\timageName\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12$\n" +
+	"\x0elast_seen_unix\x18\x06 \x01(\x03R\flastSeenUnix\"\xbc\x02\n" +
+	"\x10ContainerMetrics\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x1f\n" +
+	"\vcpu_percent\x18\x02 \x01(\x01R\n" +
+	"cpuPercent\x12!\n" +
+	"\fmemory_usage\x18\x03 \x01(\x03R\vmemoryUsage\x12!\n" +
+	"\fmemory_limit\x18\x04 \x01(\x03R\vmemoryLimit\x12%\n" +
+	"\x0ememory_percent\x18\x05 \x01(\x01R\rmemoryPercent\x12\x1d\n" +
+	"\n" +
+	"network_rx\x18\x06 \x01(\x03R\tnetworkRx\x12\x1d\n" +
+	"\n" +
+	"network_tx\x18\a \x01(\x03R\tnetworkTx\x12\x17\n" +
+	"\asize_rw\x18\b \x01(\x03R\x06sizeRw\x12 \n" +
+	"\fsize_root_fs\x18\t \x01(\x03R\n" +
+	"sizeRootFs\"\xa7\x01\n" +
+	"\tImageInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x02 \x01(\tR\n" +
+	"repository\x12\x10\n" +
+	"\x03tag\x18\x03 \x01(\tR\x03tag\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12!\n" +
+	"\fcreated_unix\x18\x05 \x01(\x03R\vcreatedUnix\x12!\n" +
+	"\fvirtual_size\x18\x06 \x01(\x03R\vvirtualSize\"\xf6\x01\n" +
+	"\vNetworkInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06driver\x18\x03 \x01(\tR\x06driver\x12\x14\n" +
+	"\x05scope\x18\x04 \x01(\tR\x05scope\x12\x1a\n" +
+	"\binternal\x18\x05 \x01(\bR\binternal\x12>\n" +
+	"\x06labels\x18\x06 \x03(\v2&.docksphinx.v1.NetworkInfo.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x83\x02\n" +
+	"\n" +
+	"VolumeInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\tR\x06driver\x12\x1e\n" +
+	"\n" +
+	"mountpoint\x18\x03 \x01(\tR\n" +
+	"mountpoint\x12=\n" +
+	"\x06labels\x18\x04 \x03(\v2%.docksphinx.v1.VolumeInfo.LabelsEntryR\x06labels\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x1b\n" +
+	"\tref_count\x18\x06 \x01(\x03R\brefCount\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xce\x01\n" +
+	"\tDiskUsage\x12\x1f\n" +
+	"\vlayers_size\x18\x01 \x01(\x03R\n" +
+	"layersSize\x12'\n" +
+	"\x0fcontainers_size\x18\x02 \x01(\x03R\x0econtainersSize\x12!\n" +
+	"\fvolumes_size\x18\x03 \x01(\x03R\vvolumesSize\x12(\n" +
+	"\x10build_cache_size\x18\x04 \x01(\x03R\x0ebuildCacheSize\x12*\n" +
+	"\x11collected_at_unix\x18\x05 \x01(\x03R\x0fcollectedAtUnix\"\x13\n" +
+	"\x11ListImagesRequest\"r\n" +
+	"\x12ListImagesResponse\x120\n" +
+	"\x06images\x18\x01 \x03(\v2\x18.docksphinx.v1.ImageInfoR\x06images\x12*\n" +
+	"\x11collected_at_unix\x18\x02 \x01(\x03R\x0fcollectedAtUnix\"\x15\n" +
+	"\x13ListNetworksRequest\"z\n" +
+	"\x14ListNetworksResponse\x126\n" +
+	"\bnetworks\x18\x01 \x03(\v2\x1a.docksphinx.v1.NetworkInfoR\bnetworks\x12*\n" +
+	"\x11collected_at_unix\x18\x02 \x01(\x03R\x0fcollectedAtUnix\"\x14\n" +
+	"\x12ListVolumesRequest\"v\n" +
+	"\x13ListVolumesResponse\x123\n" +
+	"\avolumes\x18\x01 \x03(\v2\x19.docksphinx.v1.VolumeInfoR\avolumes\x12*\n" +
+	"\x11collected_at_unix\x18\x02 \x01(\x03R\x0fcollectedAtUnix\"V\n" +
+	"\x19GetDependencyGraphRequest\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"\xb9\x01\n" +
+	"\x0fDependencyGraph\x12.\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x18.docksphinx.v1.GraphNodeR\x05nodes\x12.\n" +
+	"\x05edges\x18\x02 \x03(\v2\x18.docksphinx.v1.GraphEdgeR\x05edges\x12\x1a\n" +
+	"\brendered\x18\x03 \x01(\tR\brendered\x12*\n" +
+	"\x11collected_at_unix\x18\x04 \x01(\x03R\x0fcollectedAtUnix\"\xdc\x01\n" +
+	"\tGraphNode\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12%\n" +
+	"\x0econtainer_name\x18\x02 \x01(\tR\rcontainerName\x12\x1d\n" +
+	"\n" +
+	"image_name\x18\x03 \x01(\tR\timageName\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12'\n" +
+	"\x0fcompose_project\x18\x05 \x01(\tR\x0ecomposeProject\x12'\n" +
+	"\x0fcompose_service\x18\x06 \x01(\tR\x0ecomposeService\"w\n" +
+	"\tGraphEdge\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\x12\x1a\n" +
+	"\bdirected\x18\x05 \x01(\bR\bdirected\"\xb5\x01\n" +
+	"\x0eGetLogsRequest\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x12\n" +
+	"\x04tail\x18\x02 \x01(\x05R\x04tail\x12\x1d\n" +
+	"\n" +
+	"since_unix\x18\x03 \x01(\x03R\tsinceUnix\x12\x1d\n" +
+	"\n" +
+	"until_unix\x18\x04 \x01(\x03R\tuntilUnix\x12\x16\n" +
+	"\x06stdout\x18\x05 \x01(\bR\x06stdout\x12\x16\n" +
+	"\x06stderr\x18\x06 \x01(\bR\x06stderr\"?\n" +
+	"\x0fGetLogsResponse\x12,\n" +
+	"\x05lines\x18\x01 \x03(\v2\x16.docksphinx.v1.LogLineR\x05lines\"e\n" +
+	"\aLogLine\x12\x16\n" +
+	"\x06stream\x18\x01 \x01(\tR\x06stream\x12.\n" +
+	"\x13timestamp_unix_nano\x18\x02 \x01(\x03R\x11timestampUnixNano\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"\xc7\a\n" +
+	"\x05Event\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12%\n" +
+	"\x0etimestamp_unix\x18\x03 \x01(\x03R\rtimestampUnix\x12!\n" +
+	"\fcontainer_id\x18\x04 \x01(\tR\vcontainerId\x12%\n" +
+	"\x0econtainer_name\x18\x05 \x01(\tR\rcontainerName\x12\x1d\n" +
+	"\n" +
+	"image_name\x18\x06 \x01(\tR\timageName\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x122\n" +
+	"\x04data\x18\b \x03(\v2\x1e.docksphinx.v1.Event.DataEntryR\x04data\x12*\n" +
+	"\x04logs\x18\t \x03(\v2\x16.docksphinx.v1.LogLineR\x04logs\x123\n" +
+	"\bseverity\x18\n" +
+	" \x01(\x0e2\x17.docksphinx.v1.SeverityR\bseverity\x12F\n" +
+	"\fstate_change\x18\v \x01(\v2!.docksphinx.v1.StateChangePayloadH\x00R\vstateChange\x12?\n" +
+	"\tthreshold\x18\f \x01(\v2\x1f.docksphinx.v1.ThresholdPayloadH\x00R\tthreshold\x12I\n" +
+	"\rvolume_growth\x18\r \x01(\v2\".docksphinx.v1.VolumeGrowthPayloadH\x00R\fvolumeGrowth\x12=\n" +
+	"\tlog_match\x18\x0e \x01(\v2\x1e.docksphinx.v1.LogMatchPayloadH\x00R\blogMatch\x126\n" +
+	"\x06impact\x18\x0f \x01(\v2\x1c.docksphinx.v1.ImpactPayloadH\x00R\x06impact\x123\n" +
+	"\x05group\x18\x10 \x01(\v2\x1b.docksphinx.v1.GroupPayloadH\x00R\x05group\x12U\n" +
+	"\x11docker_connection\x18\x11 \x01(\v2&.docksphinx.v1.DockerConnectionPayloadH\x00R\x10dockerConnection\x12@\n" +
+	"\n" +
+	"crash_loop\x18\x12 \x01(\v2\x1f.docksphinx.v1.CrashLoopPayloadH\x00R\tcrashLoop\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\t\n" +
+	"\apayload\"\xd4\x01\n" +
+	"\x12StateChangePayload\x12%\n" +
+	"\x0eprevious_state\x18\x01 \x01(\tR\rpreviousState\x125\n" +
+	"\x17time_since_stop_seconds\x18\x02 \x01(\x01R\x14timeSinceStopSeconds\x12\x1e\n" +
+	"\n" +
+	"dependents\x18\x03 \x03(\tR\n" +
+	"dependents\x12#\n" +
+	"\rdependent_ids\x18\x04 \x03(\tR\fdependentIds\x12\x1b\n" +
+	"\texit_code\x18\x05 \x01(\x05R\bexitCode\"p\n" +
+	"\x10CrashLoopPayload\x12\x18\n" +
+	"\acrashes\x18\x01 \x01(\x05R\acrashes\x12%\n" +
+	"\x0ewindow_seconds\x18\x02 \x01(\x01R\rwindowSeconds\x12\x1b\n" +
+	"\texit_code\x18\x03 \x01(\x05R\bexitCode\"\x8b\x01\n" +
+	"\x10ThresholdPayload\x12\x16\n" +
+	"\x06metric\x18\x01 \x01(\tR\x06metric\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\x01R\tthreshold\x12+\n" +
+	"\x11consecutive_count\x18\x04 \x01(\x05R\x10consecutiveCount\"\xd6\x01\n" +
+	"\x13VolumeGrowthPayload\x12\x1f\n" +
+	"\vvolume_name\x18\x01 \x01(\tR\n" +
+	"volumeName\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12#\n" +
+	"\rprevious_size\x18\x03 \x01(\x03R\fpreviousSize\x12!\n" +
+	"\fgrowth_bytes\x18\x04 \x01(\x03R\vgrowthBytes\x12%\n" +
+	"\x0egrowth_percent\x18\x05 \x01(\x01R\rgrowthPercent\x12\x1b\n" +
+	"\tref_count\x18\x06 \x01(\x03R\brefCount\"\xd9\x01\n" +
+	"\x0fLogMatchPayload\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\tR\x04line\x12\x16\n" +
+	"\x06stream\x18\x03 \x01(\tR\x06stream\x12\x1f\n" +
+	"\vmatch_count\x18\x04 \x01(\x05R\n" +
+	"matchCount\x12%\n" +
+	"\x0ewindow_seconds\x18\x05 \x01(\x01R\rwindowSeconds\x12\x1e\n" +
+	"\n" +
+	"suppressed\x18\x06 \x01(\x05R\n" +
+	"suppressed\x12\x18\n" +
+	"\acontext\x18\a \x03(\tR\acontext\"\xaf\x01\n" +
+	"\rImpactPayload\x128\n" +
+	"\x19root_cause_failed_at_unix\x18\x01 \x01(\x03R\x15rootCauseFailedAtUnix\x12\x1a\n" +
+	"\baffected\x18\x02 \x03(\tR\baffected\x12!\n" +
+	"\faffected_ids\x18\x03 \x03(\tR\vaffectedIds\x12%\n" +
+	"\x0ewindow_seconds\x18\x04 \x01(\x01R\rwindowSeconds\"Z\n" +
+	"\fGroupPayload\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x1e\n" +
+	"\n" +
+	"containers\x18\x03 \x03(\tR\n" +
+	"containers\"\x9f\x01\n" +
+	"\x17DockerConnectionPayload\x12\x14\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\x12)\n" +
+	"\x10downtime_seconds\x18\x02 \x01(\x01R\x0fdowntimeSeconds\x12\x1a\n" +
+	"\battempts\x18\x03 \x01(\x05R\battempts\x12'\n" +
+	"\x0fcontainer_count\x18\x04 \x01(\x05R\x0econtainerCount\"\xc2\x01\n" +
+	"\x16GetEventHistoryRequest\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x14\n" +
+	"\x05types\x18\x02 \x03(\tR\x05types\x12\x1d\n" +
+	"\n" +
+	"since_unix\x18\x03 \x01(\x03R\tsinceUnix\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12:\n" +
+	"\fmin_severity\x18\x05 \x01(\x0e2\x17.docksphinx.v1.SeverityR\vminSeverity\"G\n" +
+	"\x17GetEventHistoryResponse\x12,\n" +
+	"\x06events\x18\x01 \x03(\v2\x14.docksphinx.v1.EventR\x06events\"<\n" +
+	"\x17GetMetricHistoryRequest\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\"_\n" +
+	"\x18GetMetricHistoryResponse\x12C\n" +
+	"\thistories\x18\x01 \x03(\v2%.docksphinx.v1.ContainerMetricHistoryR\thistories\"\x99\x01\n" +
+	"\x16ContainerMetricHistory\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12%\n" +
+	"\x0econtainer_name\x18\x02 \x01(\tR\rcontainerName\x125\n" +
+	"\asamples\x18\x03 \x03(\v2\x1b.docksphinx.v1.MetricSampleR\asamples\"\xc2\x02\n" +
+	"\fMetricSample\x12%\n" +
+	"\x0etimestamp_unix\x18\x01 \x01(\x03R\rtimestampUnix\x12\x1f\n" +
+	"\vcpu_percent\x18\x02 \x01(\x01R\n" +
+	"cpuPercent\x12!\n" +
+	"\fmemory_usage\x18\x03 \x01(\x03R\vmemoryUsage\x12%\n" +
+	"\x0ememory_percent\x18\x04 \x01(\x01R\rmemoryPercent\x12&\n" +
+	"\x0fnetwork_rx_rate\x18\x05 \x01(\x01R\rnetworkRxRate\x12&\n" +
+	"\x0fnetwork_tx_rate\x18\x06 \x01(\x01R\rnetworkTxRate\x12&\n" +
+	"\x0fblock_read_rate\x18\a \x01(\x01R\rblockReadRate\x12(\n" +
+	"\x10block_write_rate\x18\b \x01(\x01R\x0eblockWriteRate\"\xaf\x01\n" +
+	"\x13QueryMetricsRequest\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x18\n" +
+	"\ametrics\x18\x02 \x03(\tR\ametrics\x12\x1d\n" +
+	"\n" +
+	"start_unix\x18\x03 \x01(\x03R\tstartUnix\x12\x19\n" +
+	"\bend_unix\x18\x04 \x01(\x03R\aendUnix\x12!\n" +
+	"\fstep_seconds\x18\x05 \x01(\x03R\vstepSeconds\"\x8e\x01\n" +
+	"\x14QueryMetricsResponse\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x01 \x01(\tR\n" +
+	"resolution\x12!\n" +
+	"\fstep_seconds\x18\x02 \x01(\x03R\vstepSeconds\x123\n" +
+	"\x06series\x18\x03 \x03(\v2\x1b.docksphinx.v1.MetricSeriesR\x06series\"\xa4\x01\n" +
+	"\fMetricSeries\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12%\n" +
+	"\x0econtainer_name\x18\x02 \x01(\tR\rcontainerName\x12\x16\n" +
+	"\x06metric\x18\x03 \x01(\tR\x06metric\x122\n" +
+	"\x06points\x18\x04 \x03(\v2\x1a.docksphinx.v1.MetricPointR\x06points\"\x80\x01\n" +
+	"\vMetricPoint\x12%\n" +
+	"\x0etimestamp_unix\x18\x01 \x01(\x03R\rtimestampUnix\x12\x10\n" +
+	"\x03min\x18\x02 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x03 \x01(\x01R\x03max\x12\x10\n" +
+	"\x03avg\x18\x04 \x01(\x01R\x03avg\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x03R\x05count\"\xd9\x02\n" +
+	"\aSilence\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tcontainer\x18\x02 \x01(\tR\tcontainer\x12:\n" +
+	"\x06labels\x18\x03 \x03(\v2\".docksphinx.v1.Silence.LabelsEntryR\x06labels\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12&\n" +
+	"\x0fcreated_at_unix\x18\x06 \x01(\x03R\rcreatedAtUnix\x12&\n" +
+	"\x0fexpires_at_unix\x18\a \x01(\x03R\rexpiresAtUnix\x12\x1e\n" +
+	"\n" +
+	"suppressed\x18\b \x01(\x04R\n" +
+	"suppressed\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9e\x02\n" +
+	"\x14CreateSilenceRequest\x12\x1c\n" +
+	"\tcontainer\x18\x01 \x01(\tR\tcontainer\x12G\n" +
+	"\x06labels\x18\x02 \x03(\v2/.docksphinx.v1.CreateSilenceRequest.LabelsEntryR\x06labels\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x12)\n" +
+	"\x10duration_seconds\x18\x05 \x01(\x03R\x0fdurationSeconds\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"m\n" +
+	"\x13ListSilencesRequest\x12'\n" +
+	"\x0finclude_expired\x18\x01 \x01(\bR\x0eincludeExpired\x12-\n" +
+	"\x12include_suppressed\x18\x02 \x01(\bR\x11includeSuppressed\"\x8a\x01\n" +
+	"\x14ListSilencesResponse\x122\n" +
+	"\bsilences\x18\x01 \x03(\v2\x16.docksphinx.v1.SilenceR\bsilences\x12>\n" +
+	"\n" +
+	"suppressed\x18\x02 \x03(\v2\x1e.docksphinx.v1.SuppressedEventR\n" +
+	"suppressed\"\xa2\x01\n" +
+	"\x0fSuppressedEvent\x12*\n" +
+	"\x05event\x18\x01 \x01(\v2\x14.docksphinx.v1.EventR\x05event\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"silence_id\x18\x03 \x01(\tR\tsilenceId\x12,\n" +
+	"\x12suppressed_at_unix\x18\x04 \x01(\x03R\x10suppressedAtUnix\"\x18\n" +
+	"\x16GetDaemonStatusRequest\"\xbc\x04\n" +
+	"\fDaemonStatus\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12&\n" +
+	"\x0fstarted_at_unix\x18\x02 \x01(\x03R\rstartedAtUnix\x12%\n" +
+	"\x0euptime_seconds\x18\x03 \x01(\x03R\ruptimeSeconds\x12\x1f\n" +
+	"\vconfig_path\x18\x04 \x01(\tR\n" +
+	"configPath\x12\x18\n" +
+	"\ahealthy\x18\x05 \x01(\bR\ahealthy\x12%\n" +
+	"\x0ehealth_message\x18\x06 \x01(\tR\rhealthMessage\x120\n" +
+	"\x14last_collection_unix\x18\a \x01(\x03R\x12lastCollectionUnix\x12G\n" +
+	" last_collection_duration_seconds\x18\b \x01(\x01R\x1dlastCollectionDurationSeconds\x122\n" +
+	"\x15last_collection_error\x18\t \x01(\tR\x13lastCollectionError\x12-\n" +
+	"\x12tracked_containers\x18\n" +
+	" \x01(\x05R\x11trackedContainers\x12 \n" +
+	"\vsubscribers\x18\v \x01(\x05R\vsubscribers\x12%\n" +
+	"\x0edropped_events\x18\f \x01(\x04R\rdroppedEvents\x12:\n" +
+	"\x19dropped_subscriber_events\x18\r \x01(\x04R\x17droppedSubscriberEvents*d\n" +
+	"\bSeverity\x12\x18\n" +
+	"\x14SEVERITY_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSEVERITY_INFO\x10\x01\x12\x14\n" +
+	"\x10SEVERITY_WARNING\x10\x02\x12\x15\n" +
+	"\x11SEVERITY_CRITICAL\x10\x032\xb6\t\n" +
+	"\x11DocksphinxService\x12I\n" +
+	"\vGetSnapshot\x12!.docksphinx.v1.GetSnapshotRequest\x1a\x17.docksphinx.v1.Snapshot\x12E\n" +
+	"\x06Stream\x12\x1c.docksphinx.v1.StreamRequest\x1a\x1b.docksphinx.v1.StreamUpdate0\x01\x12Q\n" +
+	"\n" +
+	"ListImages\x12 .docksphinx.v1.ListImagesRequest\x1a!.docksphinx.v1.ListImagesResponse\x12W\n" +
+	"\fListNetworks\x12\".docksphinx.v1.ListNetworksRequest\x1a#.docksphinx.v1.ListNetworksResponse\x12T\n" +
+	"\vListVolumes\x12!.docksphinx.v1.ListVolumesRequest\x1a\".docksphinx.v1.ListVolumesResponse\x12^\n" +
+	"\x12GetDependencyGraph\x12(.docksphinx.v1.GetDependencyGraphRequest\x1a\x1e.docksphinx.v1.DependencyGraph\x12H\n" +
+	"\aGetLogs\x12\x1d.docksphinx.v1.GetLogsRequest\x1a\x1e.docksphinx.v1.GetLogsResponse\x12E\n" +
+	"\n" +
+	"FollowLogs\x12\x1d.docksphinx.v1.GetLogsRequest\x1a\x16.docksphinx.v1.LogLine0\x01\x12`\n" +
+	"\x0fGetEventHistory\x12%.docksphinx.v1.GetEventHistoryRequest\x1a&.docksphinx.v1.GetEventHistoryResponse\x12c\n" +
+	"\x10GetMetricHistory\x12&.docksphinx.v1.GetMetricHistoryRequest\x1a'.docksphinx.v1.GetMetricHistoryResponse\x12W\n" +
+	"\fQueryMetrics\x12\".docksphinx.v1.QueryMetricsRequest\x1a#.docksphinx.v1.QueryMetricsResponse\x12L\n" +
+	"\rCreateSilence\x12#.docksphinx.v1.CreateSilenceRequest\x1a\x16.docksphinx.v1.Silence\x12W\n" +
+	"\fListSilences\x12\".docksphinx.v1.ListSilencesRequest\x1a#.docksphinx.v1.ListSilencesResponse\x12U\n" +
+	"\x0fGetDaemonStatus\x12%.docksphinx.v1.GetDaemonStatusRequest\x1a\x1b.docksphinx.v1.DaemonStatusB+Z)docksphinx/api/docksphinx/v1;docksphinxv1b\x06proto3"
+
+var (
+	file_docksphinx_v1_docksphinx_proto_rawDescOnce sync.Once
+	file_docksphinx_v1_docksphinx_proto_rawDescData []byte
+)
+
+func file_docksphinx_v1_docksphinx_proto_rawDescGZIP() []byte {
+	file_docksphinx_v1_docksphinx_proto_rawDescOnce.Do(func() {
+		file_docksphinx_v1_docksphinx_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_docksphinx_v1_docksphinx_proto_rawDesc), len(file_docksphinx_v1_docksphinx_proto_rawDesc)))
+	})
+	return file_docksphinx_v1_docksphinx_proto_rawDescData
+}
+
+var file_docksphinx_v1_docksphinx_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_docksphinx_v1_docksphinx_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
+var file_docksphinx_v1_docksphinx_proto_goTypes = []any{
+	(Severity)(0),                     // 0: docksphinx.v1.Severity
+	(*GetSnapshotRequest)(nil),        // 1: docksphinx.v1.GetSnapshotRequest
+	(*StreamRequest)(nil),             // 2: docksphinx.v1.StreamRequest
+	(*StreamUpdate)(nil),              // 3: docksphinx.v1.StreamUpdate
+	(*Snapshot)(nil),                  // 4: docksphinx.v1.Snapshot
+	(*ContainerInfo)(nil),             // 5: docksphinx.v1.ContainerInfo
+	(*ContainerMetrics)(nil),          // 6: docksphinx.v1.ContainerMetrics
+	(*ImageInfo)(nil),                 // 7: docksphinx.v1.ImageInfo
+	(*NetworkInfo)(nil),               // 8: docksphinx.v1.NetworkInfo
+	(*VolumeInfo)(nil),                // 9: docksphinx.v1.VolumeInfo
+	(*DiskUsage)(nil),                 // 10: docksphinx.v1.DiskUsage
+	(*ListImagesRequest)(nil),         // 11: docksphinx.v1.ListImagesRequest
+	(*ListImagesResponse)(nil),        // 12: docksphinx.v1.ListImagesResponse
+	(*ListNetworksRequest)(nil),       // 13: docksphinx.v1.ListNetworksRequest
+	(*ListNetworksResponse)(nil),      // 14: docksphinx.v1.ListNetworksResponse
+	(*ListVolumesRequest)(nil),        // 15: docksphinx.v1.ListVolumesRequest
+	(*ListVolumesResponse)(nil),       // 16: docksphinx.v1.ListVolumesResponse
+	(*GetDependencyGraphRequest)(nil), // 17: docksphinx.v1.GetDependencyGraphRequest
+	(*DependencyGraph)(nil),           // 18: docksphinx.v1.DependencyGraph
+	(*GraphNode)(nil),                 // 19: docksphinx.v1.GraphNode
+	(*GraphEdge)(nil),                 // 20: docksphinx.v1.GraphEdge
+	(*GetLogsRequest)(nil),            // 21: docksphinx.v1.GetLogsRequest
+	(*GetLogsResponse)(nil),           // 22: docksphinx.v1.GetLogsResponse
+	(*LogLine)(nil),                   // 23: docksphinx.v1.LogLine
+	(*Event)(nil),                     // 24: docksphinx.v1.Event
+	(*StateChangePayload)(nil),        // 25: docksphinx.v1.StateChangePayload
+	(*CrashLoopPayload)(nil),          // 26: docksphinx.v1.CrashLoopPayload
+	(*ThresholdPayload)(nil),          // 27: docksphinx.v1.ThresholdPayload
+	(*VolumeGrowthPayload)(nil),       // 28: docksphinx.v1.VolumeGrowthPayload
+	(*LogMatchPayload)(nil),           // 29: docksphinx.v1.LogMatchPayload
+	(*ImpactPayload)(nil),             // 30: docksphinx.v1.ImpactPayload
+	(*GroupPayload)(nil),              // 31: docksphinx.v1.GroupPayload
+	(*DockerConnectionPayload)(nil),   // 32: docksphinx.v1.DockerConnectionPayload
+	(*GetEventHistoryRequest)(nil),    // 33: docksphinx.v1.GetEventHistoryRequest
+	(*GetEventHistoryResponse)(nil),   // 34: docksphinx.v1.GetEventHistoryResponse
+	(*GetMetricHistoryRequest)(nil),   // 35: docksphinx.v1.GetMetricHistoryRequest
+	(*GetMetricHistoryResponse)(nil),  // 36: docksphinx.v1.GetMetricHistoryResponse
+	(*ContainerMetricHistory)(nil),    // 37: docksphinx.v1.ContainerMetricHistory
+	(*MetricSample)(nil),              // 38: docksphinx.v1.MetricSample
+	(*QueryMetricsRequest)(nil),       // 39: docksphinx.v1.QueryMetricsRequest
+	(*QueryMetricsResponse)(nil),      // 40: docksphinx.v1.QueryMetricsResponse
+	(*MetricSeries)(nil),              // 41: docksphinx.v1.MetricSeries
+	(*MetricPoint)(nil),               // 42: docksphinx.v1.MetricPoint
+	(*Silence)(nil),                   // 43: docksphinx.v1.Silence
+	(*CreateSilenceRequest)(nil),      // 44: docksphinx.v1.CreateSilenceRequest
+	(*ListSilencesRequest)(nil),       // 45: docksphinx.v1.ListSilencesRequest
+	(*ListSilencesResponse)(nil),      // 46: docksphinx.v1.ListSilencesResponse
+	(*SuppressedEvent)(nil),           // 47: docksphinx.v1.SuppressedEvent
+	(*GetDaemonStatusRequest)(nil),    // 48: docksphinx.v1.GetDaemonStatusRequest
+	(*DaemonStatus)(nil),              // 49: docksphinx.v1.DaemonStatus
+	nil,                               // 50: docksphinx.v1.Snapshot.MetricsEntry
+	nil,                               // 51: docksphinx.v1.NetworkInfo.LabelsEntry
+	nil,                               // 52: docksphinx.v1.VolumeInfo.LabelsEntry
+	nil,                               // 53: docksphinx.v1.Event.DataEntry
+	nil,                               // 54: docksphinx.v1.Silence.LabelsEntry
+	nil,                               // 55: docksphinx.v1.CreateSilenceRequest.LabelsEntry
+}
+var file_docksphinx_v1_docksphinx_proto_depIdxs = []int32{
+	0,  // 0: docksphinx.v1.StreamRequest.min_severity:type_name -> docksphinx.v1.Severity
+	4,  // 1: docksphinx.v1.StreamUpdate.snapshot:type_name -> docksphinx.v1.Snapshot
+	24, // 2: docksphinx.v1.StreamUpdate.event:type_name -> docksphinx.v1.Event
+	5,  // 3: docksphinx.v1.Snapshot.containers:type_name -> docksphinx.v1.ContainerInfo
+	50, // 4: docksphinx.v1.Snapshot.metrics:type_name -> docksphinx.v1.Snapshot.MetricsEntry
+	7,  // 5: docksphinx.v1.Snapshot.images:type_name -> docksphinx.v1.ImageInfo
+	8,  // 6: docksphinx.v1.Snapshot.networks:type_name -> docksphinx.v1.NetworkInfo
+	9,  // 7: docksphinx.v1.Snapshot.volumes:type_name -> docksphinx.v1.VolumeInfo
+	10, // 8: docksphinx.v1.Snapshot.disk_usage:type_name -> docksphinx.v1.DiskUsage
+	51, // 9: docksphinx.v1.NetworkInfo.labels:type_name -> docksphinx.v1.NetworkInfo.LabelsEntry
+	52, // 10: docksphinx.v1.VolumeInfo.labels:type_name -> docksphinx.v1.VolumeInfo.LabelsEntry
+	7,  // 11: docksphinx.v1.ListImagesResponse.images:type_name -> docksphinx.v1.ImageInfo
+	8,  // 12: docksphinx.v1.ListNetworksResponse.networks:type_name -> docksphinx.v1.NetworkInfo
+	9,  // 13: docksphinx.v1.ListVolumesResponse.volumes:type_name -> docksphinx.v1.VolumeInfo
+	19, // 14: docksphinx.v1.DependencyGraph.nodes:type_name -> docksphinx.v1.GraphNode
+	20, // 15: docksphinx.v1.DependencyGraph.edges:type_name -> docksphinx.v1.GraphEdge
+	23, // 16: docksphinx.v1.GetLogsResponse.lines:type_name -> docksphinx.v1.LogLine
+	53, // 17: docksphinx.v1.Event.data:type_name -> docksphinx.v1.Event.DataEntry
+	23, // 18: docksphinx.v1.Event.logs:type_name -> docksphinx.v1.LogLine
+	0,  // 19: docksphinx.v1.Event.severity:type_name -> docksphinx.v1.Severity
+	25, // 20: docksphinx.v1.Event.state_change:type_name -> docksphinx.v1.StateChangePayload
+	27, // 21: docksphinx.v1.Event.threshold:type_name -> docksphinx.v1.ThresholdPayload
+	28, // 22: docksphinx.v1.Event.volume_growth:type_name -> docksphinx.v1.VolumeGrowthPayload
+	29, // 23: docksphinx.v1.Event.log_match:type_name -> docksphinx.v1.LogMatchPayload
+	30, // 24: docksphinx.v1.Event.impact:type_name -> docksphinx.v1.ImpactPayload
+	31, // 25: docksphinx.v1.Event.group:type_name -> docksphinx.v1.GroupPayload
+	32, // 26: docksphinx.v1.Event.docker_connection:type_name -> docksphinx.v1.DockerConnectionPayload
+	26, // 27: docksphinx.v1.Event.crash_loop:type_name -> docksphinx.v1.CrashLoopPayload
+	0,  // 28: docksphinx.v1.GetEventHistoryRequest.min_severity:type_name -> docksphinx.v1.Severity
+	24, // 29: docksphinx.v1.GetEventHistoryResponse.events:type_name -> docksphinx.v1.Event
+	37, // 30: docksphinx.v1.GetMetricHistoryResponse.histories:type_name -> docksphinx.v1.ContainerMetricHistory
+	38, // 31: docksphinx.v1.ContainerMetricHistory.samples:type_name -> docksphinx.v1.MetricSample
+	41, // 32: docksphinx.v1.QueryMetricsResponse.series:type_name -> docksphinx.v1.MetricSeries
+	42, // 33: docksphinx.v1.MetricSeries.points:type_name -> docksphinx.v1.MetricPoint
+	54, // 34: docksphinx.v1.Silence.labels:type_name -> docksphinx.v1.Silence.LabelsEntry
+	55, // 35: docksphinx.v1.CreateSilenceRequest.labels:type_name -> docksphinx.v1.CreateSilenceRequest.LabelsEntry
+	43, // 36: docksphinx.v1.ListSilencesResponse.silences:type_name -> docksphinx.v1.Silence
+	47, // 37: docksphinx.v1.ListSilencesResponse.suppressed:type_name -> docksphinx.v1.SuppressedEvent
+	24, // 38: docksphinx.v1.SuppressedEvent.event:type_name -> docksphinx.v1.Event
+	6,  // 39: docksphinx.v1.Snapshot.MetricsEntry.value:type_name -> docksphinx.v1.ContainerMetrics
+	1,  // 40: docksphinx.v1.DocksphinxService.GetSnapshot:input_type -> docksphinx.v1.GetSnapshotRequest
+	2,  // 41: docksphinx.v1.DocksphinxService.Stream:input_type -> docksphinx.v1.StreamRequest
+	11, // 42: docksphinx.v1.DocksphinxService.ListImages:input_type -> docksphinx.v1.ListImagesRequest
+	13, // 43: docksphinx.v1.DocksphinxService.ListNetworks:input_type -> docksphinx.v1.ListNetworksRequest
+	15, // 44: docksphinx.v1.DocksphinxService.ListVolumes:input_type -> docksphinx.v1.ListVolumesRequest
+	17, // 45: docksphinx.v1.DocksphinxService.GetDependencyGraph:input_type -> docksphinx.v1.GetDependencyGraphRequest
+	21, // 46: docksphinx.v1.DocksphinxService.GetLogs:input_type -> docksphinx.v1.GetLogsRequest
+	21, // 47: docksphinx.v1.DocksphinxService.FollowLogs:input_type -> docksphinx.v1.GetLogsRequest
+	33, // 48: docksphinx.v1.DocksphinxService.GetEventHistory:input_type -> docksphinx.v1.GetEventHistoryRequest
+	35, // 49: docksphinx.v1.DocksphinxService.GetMetricHistory:input_type -> docksphinx.v1.GetMetricHistoryRequest
+	39, // 50: docksphinx.v1.DocksphinxService.QueryMetrics:input_type -> docksphinx.v1.QueryMetricsRequest
+	44, // 51: docksphinx.v1.DocksphinxService.CreateSilence:input_type -> docksphinx.v1.CreateSilenceRequest
+	45, // 52: docksphinx.v1.DocksphinxService.ListSilences:input_type -> docksphinx.v1.ListSilencesRequest
+	48, // 53: docksphinx.v1.DocksphinxService.GetDaemonStatus:input_type -> docksphinx.v1.GetDaemonStatusRequest
+	4,  // 54: docksphinx.v1.DocksphinxService.GetSnapshot:output_type -> docksphinx.v1.Snapshot
+	3,  // 55: docksphinx.v1.DocksphinxService.Stream:output_type -> docksphinx.v1.StreamUpdate
+	12, // 56: docksphinx.v1.DocksphinxService.ListImages:output_type -> docksphinx.v1.ListImagesResponse
+	14, // 57: docksphinx.v1.DocksphinxService.ListNetworks:output_type -> docksphinx.v1.ListNetworksResponse
+	16, // 58: docksphinx.v1.DocksphinxService.ListVolumes:output_type -> docksphinx.v1.ListVolumesResponse
+	18, // 59: docksphinx.v1.DocksphinxService.GetDependencyGraph:output_type -> docksphinx.v1.DependencyGraph
+	22, // 60: docksphinx.v1.DocksphinxService.GetLogs:output_type -> docksphinx.v1.GetLogsResponse
+	23, // 61: docksphinx.v1.DocksphinxService.FollowLogs:output_type -> docksphinx.v1.LogLine
+	34, // 62: docksphinx.v1.DocksphinxService.GetEventHistory:output_type -> docksphinx.v1.GetEventHistoryResponse
+	36, // 63: docksphinx.v1.DocksphinxService.GetMetricHistory:output_type -> docksphinx.v1.GetMetricHistoryResponse
+	40, // 64: docksphinx.v1.DocksphinxService.QueryMetrics:output_type -> docksphinx.v1.QueryMetricsResponse
+	43, // 65: docksphinx.v1.DocksphinxService.CreateSilence:output_type -> docksphinx.v1.Silence
+	46, // 66: docksphinx.v1.DocksphinxService.ListSilences:output_type -> docksphinx.v1.ListSilencesResponse
+	49, // 67: docksphinx.v1.DocksphinxService.GetDaemonStatus:output_type -> docksphinx.v1.DaemonStatus
+	54, // [54:68] is the sub-list for method output_type
+	40, // [40:54] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
+}
+
+func init() { file_docksphinx_v1_docksphinx_proto_init() }
+func file_docksphinx_v1_docksphinx_proto_init() {
+	if File_docksphinx_v1_docksphinx_proto != nil {
+		return
+	}
+	file_docksphinx_v1_docksphinx_proto_msgTypes[2].OneofWrappers = []any{
+		(*StreamUpdate_Snapshot)(nil),
+		(*StreamUpdate_Event)(nil),
+	}
+	file_docksphinx_v1_docksphinx_proto_msgTypes[23].OneofWrappers = []any{
+		(*Event_StateChange)(nil),
+		(*Event_Threshold)(nil),
+		(*Event_VolumeGrowth)(nil),
+		(*Event_LogMatch)(nil),
+		(*Event_Impact)(nil),
+		(*Event_Group)(nil),
+		(*Event_DockerConnection)(nil),
+		(*Event_CrashLoop)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_docksphinx_v1_docksphinx_proto_rawDesc), len(file_docksphinx_v1_docksphinx_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   55,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_docksphinx_v1_docksphinx_proto_goTypes,
+		DependencyIndexes: file_docksphinx_v1_docksphinx_proto_depIdxs,
+		EnumInfos:         file_docksphinx_v1_docksphinx_proto_enumTypes,
+		MessageInfos:      file_docksphinx_v1_docksphinx_proto_msgTypes,
+	}.Build()
+	File_docksphinx_v1_docksphinx_proto = out.File
+	file_docksphinx_v1_docksphinx_proto_goTypes = nil
+	file_docksphinx_v1_docksphinx_proto_depIdxs = nil
+}
@@ -0,0 +1,654 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: docksphinx/v1/docksphinx.proto
+
+package docksphinxv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DocksphinxService_GetSnapshot_FullMethodName        = "/docksphinx.v1.DocksphinxService/GetSnapshot"
+	DocksphinxService_Stream_FullMethodName             = "/docksphinx.v1.DocksphinxService/Stream"
+	DocksphinxService_ListImages_FullMethodName         = "/docksphinx.v1.DocksphinxService/ListImages"
+	DocksphinxService_ListNetworks_FullMethodName       = "/docksphinx.v1.DocksphinxService/ListNetworks"
+	DocksphinxService_ListVolumes_FullMethodName        = "/docksphinx.v1.DocksphinxService/ListVolumes"
+	DocksphinxService_GetDependencyGraph_FullMethodName = "/docksphinx.v1.DocksphinxService/GetDependencyGraph"
+	DocksphinxService_GetLogs_FullMethodName            = "/docksphinx.v1.DocksphinxService/GetLogs"
+	DocksphinxService_FollowLogs_FullMethodName         = "/docksphinx.v1.DocksphinxService/FollowLogs"
+	DocksphinxService_GetEventHistory_FullMethodName    = "/docksphinx.v1.DocksphinxService/GetEventHistory"
+	DocksphinxService_GetMetricHistory_FullMethodName   = "/docksphinx.v1.DocksphinxService/GetMetricHistory"
+	DocksphinxService_QueryMetrics_FullMethodName       = "/docksphinx.v1.DocksphinxService/QueryMetrics"
+	DocksphinxService_CreateSilence_FullMethodName      = "/docksphinx.v1.DocksphinxService/CreateSilence"
+	DocksphinxService_ListSilences_FullMethodName       = "/docksphinx.v1.DocksphinxService/ListSilences"
+	DocksphinxService_GetDaemonStatus_FullMethodName    = "/docksphinx.v1.DocksphinxService/GetDaemonStatus"
+)
+
+// DocksphinxServiceClient is the client API for DocksphinxService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DocksphinxService is the API served by docksphinxd
+type DocksphinxServiceClient interface {
+	// GetSnapshot returns the current monitoring state
+	GetSnapshot(ctx context.Context, in *GetSnapshotRequest, opts ...grpc.CallOption) (*Snapshot, error)
+	// Stream sends events in real time
+	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamUpdate], error)
+	// ListImages returns the last collected image inventory
+	ListImages(ctx context.Context, in *ListImagesRequest, opts ...grpc.CallOption) (*ListImagesResponse, error)
+	// ListNetworks returns the last collected network inventory
+	ListNetworks(ctx context.Context, in *ListNetworksRequest, opts ...grpc.CallOption) (*ListNetworksResponse, error)
+	// ListVolumes returns the last collected volume inventory
+	ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error)
+	// GetDependencyGraph returns the container dependency graph
+	GetDependencyGraph(ctx context.Context, in *GetDependencyGraphRequest, opts ...grpc.CallOption) (*DependencyGraph, error)
+	// GetLogs returns container logs
+	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
+	// FollowLogs streams container logs until the client cancels or the container stops
+	FollowLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogLine], error)
+	// GetEventHistory returns recent events kept in memory by the daemon
+	GetEventHistory(ctx context.Context, in *GetEventHistoryRequest, opts ...grpc.CallOption) (*GetEventHistoryResponse, error)
+	// GetMetricHistory returns recent metric samples of containers
+	GetMetricHistory(ctx context.Context, in *GetMetricHistoryRequest, opts ...grpc.CallOption) (*GetMetricHistoryResponse, error)
+	// QueryMetrics returns stored metrics over a time range, downsampled to a step
+	QueryMetrics(ctx context.Context, in *QueryMetricsRequest, opts ...grpc.CallOption) (*QueryMetricsResponse, error)
+	// CreateSilence suppresses notifications of matching events for a duration
+	CreateSilence(ctx context.Context, in *CreateSilenceRequest, opts ...grpc.CallOption) (*Silence, error)
+	// ListSilences returns silences and recently suppressed events
+	ListSilences(ctx context.Context, in *ListSilencesRequest, opts ...grpc.CallOption) (*ListSilencesResponse, error)
+	// GetDaemonStatus returns the state of docksphinxd itself
+	GetDaemonStatus(ctx context.Context, in *GetDaemonStatusRequest, opts ...grpc.CallOption) (*DaemonStatus, error)
+}
+
+type docksphinxServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDocksphinxServiceClient(cc grpc.ClientConnInterface) DocksphinxServiceClient {
+	return &docksphinxServiceClient{cc}
+}
+
+func (c *docksphinxServiceClient) GetSnapshot(ctx context.Context, in *GetSnapshotRequest, opts ...grpc.CallOption) (*Snapshot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Snapshot)
+	err := c.cc.Invoke(ctx, DocksphinxService_GetSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *docksphinxServiceClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DocksphinxService_ServiceDesc.Streams[0], DocksphinxService_Stream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamRequest, StreamUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DocksphinxService_StreamClient = grpc.ServerStreamingClient[StreamUpdate]
+
+func (c *docksphinxServiceClient) ListImages(ctx context.Context, in *ListImagesRequest, opts ...grpc.CallOption) (*ListImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListImagesResponse)
+	err := c.cc.Invoke(ctx, DocksphinxService_ListImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *docksphinxServiceClient) ListNetworks(ctx context.Context, in *ListNetworksRequest, opts ...grpc.CallOption) (*ListNetworksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNetworksResponse)
+	err := c.cc.Invoke(ctx, DocksphinxService_ListNetworks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *docksphinxServiceClient) ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVolumesResponse)
+	err := c.cc.Invoke(ctx, DocksphinxService_ListVolumes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *docksphinxServiceClient) GetDependencyGraph(ctx context.Context, in *GetDependencyGraphRequest, opts ...grpc.CallOption) (*DependencyGraph, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DependencyGraph)
+	err := c.cc.Invoke(ctx, DocksphinxService_GetDependencyGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *docksphinxServiceClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLogsResponse)
+	err := c.cc.Invoke(ctx, DocksphinxService_GetLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *docksphinxServiceClient) FollowLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogLine], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DocksphinxService_ServiceDesc.Streams[1], DocksphinxService_FollowLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetLogsRequest, LogLine]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DocksphinxService_FollowLogsClient = grpc.ServerStreamingClient[LogLine]
+
+func (c *docksphinxServiceClient) GetEventHistory(ctx context.Context, in *GetEventHistoryRequest, opts ...grpc.CallOption) (*GetEventHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEventHistoryResponse)
+	err := c.cc.Invoke(ctx, DocksphinxService_GetEventHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *docksphinxServiceClient) GetMetricHistory(ctx context.Context, in *GetMetricHistoryRequest, opts ...grpc.CallOption) (*GetMetricHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMetricHistoryResponse)
+	err := c.cc.Invoke(ctx, DocksphinxService_GetMetricHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *docksphinxServiceClient) QueryMetrics(ctx context.Context, in *QueryMetricsRequest, opts ...grpc.CallOption) (*QueryMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMetricsResponse)
+	err := c.cc.Invoke(ctx, DocksphinxService_QueryMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *docksphinxServiceClient) CreateSilence(ctx context.Context, in *CreateSilenceRequest, opts ...grpc.CallOption) (*Silence, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Silence)
+	err := c.cc.Invoke(ctx, DocksphinxService_CreateSilence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *docksphinxServiceClient) ListSilences(ctx context.Context, in *ListSilencesRequest, opts ...grpc.CallOption) (*ListSilencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSilencesResponse)
+	err := c.cc.Invoke(ctx, DocksphinxService_ListSilences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *docksphinxServiceClient) GetDaemonStatus(ctx context.Context, in *GetDaemonStatusRequest, opts ...grpc.CallOption) (*DaemonStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaemonStatus)
+	err := c.cc.Invoke(ctx, DocksphinxService_GetDaemonStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DocksphinxServiceServer is the server API for DocksphinxService service.
+// All implementations must embed UnimplementedDocksphinxServiceServer
+// for forward compatibility.
+//
+// DocksphinxService is the API served by docksphinxd
+type DocksphinxServiceServer interface {
+	// GetSnapshot returns the current monitoring state
+	GetSnapshot(context.Context, *GetSnapshotRequest) (*Snapshot, error)
+	// Stream sends events in real time
+	Stream(*StreamRequest, grpc.ServerStreamingServer[StreamUpdate]) error
+	// ListImages returns the last collected image inventory
+	ListImages(context.Context, *ListImagesRequest) (*ListImagesResponse, error)
+	// ListNetworks returns the last collected network inventory
+	ListNetworks(context.Context, *ListNetworksRequest) (*ListNetworksResponse, error)
+	// ListVolumes returns the last collected volume inventory
+	ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error)
+	// GetDependencyGraph returns the container dependency graph
+	GetDependencyGraph(context.Context, *GetDependencyGraphRequest) (*DependencyGraph, error)
+	// GetLogs returns container logs
+	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
+	// FollowLogs streams container logs until the client cancels or the container stops
+	FollowLogs(*GetLogsRequest, grpc.ServerStreamingServer[LogLine]) error
+	// GetEventHistory returns recent events kept in memory by the daemon
+	GetEventHistory(context.Context, *GetEventHistoryRequest) (*GetEventHistoryResponse, error)
+	// GetMetricHistory returns recent metric samples of containers
+	GetMetricHistory(context.Context, *GetMetricHistoryRequest) (*GetMetricHistoryResponse, error)
+	// QueryMetrics returns stored metrics over a time range, downsampled to a step
+	QueryMetrics(context.Context, *QueryMetricsRequest) (*QueryMetricsResponse, error)
+	// CreateSilence suppresses notifications of matching events for a duration
+	CreateSilence(context.Context, *CreateSilenceRequest) (*Silence, error)
+	// ListSilences returns silences and recently suppressed events
+	ListSilences(context.Context, *ListSilencesRequest) (*ListSilencesResponse, error)
+	// GetDaemonStatus returns the state of docksphinxd itself
+	GetDaemonStatus(context.Context, *GetDaemonStatusRequest) (*DaemonStatus, error)
+	mustEmbedUnimplementedDocksphinxServiceServer()
+}
+
+// UnimplementedDocksphinxServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDocksphinxServiceServer struct{}
+
+func (UnimplementedDocksphinxServiceServer) GetSnapshot(context.Context, *GetSnapshotRequest) (*Snapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshot not implemented")
+}
+func (UnimplementedDocksphinxServiceServer) Stream(*StreamRequest, grpc.ServerStreamingServer[StreamUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (UnimplementedDocksphinxServiceServer) ListImages(context.Context, *ListImagesRequest) (*ListImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImages not implemented")
+}
+func (UnimplementedDocksphinxServiceServer) ListNetworks(context.Context, *ListNetworksRequest) (*ListNetworksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNetworks not implemented")
+}
+func (UnimplementedDocksphinxServiceServer) ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVolumes not implemented")
+}
+func (UnimplementedDocksphinxServiceServer) GetDependencyGraph(context.Context, *GetDependencyGraphRequest) (*DependencyGraph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDependencyGraph not implemented")
+}
+func (UnimplementedDocksphinxServiceServer) GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
+}
+func (UnimplementedDocksphinxServiceServer) FollowLogs(*GetLogsRequest, grpc.ServerStreamingServer[LogLine]) error {
+	return status.Errorf(codes.Unimplemented, "method FollowLogs not implemented")
+}
+func (UnimplementedDocksphinxServiceServer) GetEventHistory(context.Context, *GetEventHistoryRequest) (*GetEventHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventHistory not implemented")
+}
+func (UnimplementedDocksphinxServiceServer) GetMetricHistory(context.Context, *GetMetricHistoryRequest) (*GetMetricHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetricHistory not implemented")
+}
+func (UnimplementedDocksphinxServiceServer) QueryMetrics(context.Context, *QueryMetricsRequest) (*QueryMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMetrics not implemented")
+}
+func (UnimplementedDocksphinxServiceServer) CreateSilence(context.Context, *CreateSilenceRequest) (*Silence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSilence not implemented")
+}
+func (UnimplementedDocksphinxServiceServer) ListSilences(context.Context, *ListSilencesRequest) (*ListSilencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSilences not implemented")
+}
+func (UnimplementedDocksphinxServiceServer) GetDaemonStatus(context.Context, *GetDaemonStatusRequest) (*DaemonStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDaemonStatus not implemented")
+}
+func (UnimplementedDocksphinxServiceServer) mustEmbedUnimplementedDocksphinxServiceServer() {}
+func (UnimplementedDocksphinxServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeDocksphinxServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DocksphinxServiceServer will
+// result in compilation errors.
+type UnsafeDocksphinxServiceServer interface {
+	mustEmbedUnimplementedDocksphinxServiceServer()
+}
+
+func RegisterDocksphinxServiceServer(s grpc.ServiceRegistrar, srv DocksphinxServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDocksphinxServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DocksphinxService_ServiceDesc, srv)
+}
+
+func _DocksphinxService_GetSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocksphinxServiceServer).GetSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocksphinxService_GetSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocksphinxServiceServer).GetSnapshot(ctx, req.(*GetSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocksphinxService_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DocksphinxServiceServer).Stream(m, &grpc.GenericServerStream[StreamRequest, StreamUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DocksphinxService_StreamServer = grpc.ServerStreamingServer[StreamUpdate]
+
+func _DocksphinxService_ListImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocksphinxServiceServer).ListImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocksphinxService_ListImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocksphinxServiceServer).ListImages(ctx, req.(*ListImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocksphinxService_ListNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNetworksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocksphinxServiceServer).ListNetworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocksphinxService_ListNetworks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocksphinxServiceServer).ListNetworks(ctx, req.(*ListNetworksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocksphinxService_ListVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocksphinxServiceServer).ListVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocksphinxService_ListVolumes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocksphinxServiceServer).ListVolumes(ctx, req.(*ListVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocksphinxService_GetDependencyGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDependencyGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocksphinxServiceServer).GetDependencyGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocksphinxService_GetDependencyGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocksphinxServiceServer).GetDependencyGraph(ctx, req.(*GetDependencyGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocksphinxService_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocksphinxServiceServer).GetLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocksphinxService_GetLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocksphinxServiceServer).GetLogs(ctx, req.(*GetLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocksphinxService_FollowLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DocksphinxServiceServer).FollowLogs(m, &grpc.GenericServerStream[GetLogsRequest, LogLine]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DocksphinxService_FollowLogsServer = grpc.ServerStreamingServer[LogLine]
+
+func _DocksphinxService_GetEventHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocksphinxServiceServer).GetEventHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocksphinxService_GetEventHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocksphinxServiceServer).GetEventHistory(ctx, req.(*GetEventHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocksphinxService_GetMetricHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetricHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocksphinxServiceServer).GetMetricHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocksphinxService_GetMetricHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocksphinxServiceServer).GetMetricHistory(ctx, req.(*GetMetricHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocksphinxService_QueryMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocksphinxServiceServer).QueryMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocksphinxService_QueryMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocksphinxServiceServer).QueryMetrics(ctx, req.(*QueryMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocksphinxService_CreateSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocksphinxServiceServer).CreateSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocksphinxService_CreateSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocksphinxServiceServer).CreateSilence(ctx, req.(*CreateSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocksphinxService_ListSilences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSilencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocksphinxServiceServer).ListSilences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocksphinxService_ListSilences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocksphinxServiceServer).ListSilences(ctx, req.(*ListSilencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocksphinxService_GetDaemonStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDaemonStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocksphinxServiceServer).GetDaemonStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocksphinxService_GetDaemonStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocksphinxServiceServer).GetDaemonStatus(ctx, req.(*GetDaemonStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DocksphinxService_ServiceDesc is the grpc.ServiceDesc for DocksphinxService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DocksphinxService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "docksphinx.v1.DocksphinxService",
+	HandlerType: (*DocksphinxServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSnapshot",
+			Handler:    _DocksphinxService_GetSnapshot_Handler,
+		},
+		{
+			MethodName: "ListImages",
+			Handler:    _DocksphinxService_ListImages_Handler,
+		},
+		{
+			MethodName: "ListNetworks",
+			Handler:    _DocksphinxService_ListNetworks_Handler,
+		},
+		{
+			MethodName: "ListVolumes",
+			Handler:    _DocksphinxService_ListVolumes_Handler,
+		},
+		{
+			MethodName: "GetDependencyGraph",
+			Handler:    _DocksphinxService_GetDependencyGraph_Handler,
+		},
+		{
+			MethodName: "GetLogs",
+			Handler:    _DocksphinxService_GetLogs_Handler,
+		},
+		{
+			MethodName: "GetEventHistory",
+			Handler:    _DocksphinxService_GetEventHistory_Handler,
+		},
+		{
+			MethodName: "GetMetricHistory",
+			Handler:    _DocksphinxService_GetMetricHistory_Handler,
+		},
+		{
+			MethodName: "QueryMetrics",
+			Handler:    _DocksphinxService_QueryMetrics_Handler,
+		},
+		{
+			MethodName: "CreateSilence",
+			Handler:    _DocksphinxService_CreateSilence_Handler,
+		},
+		{
+			MethodName: "ListSilences",
+			Handler:    _DocksphinxService_ListSilences_Handler,
+		},
+		{
+			MethodName: "GetDaemonStatus",
+			Handler:    _DocksphinxService_GetDaemonStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			Handler:       _DocksphinxService_Stream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FollowLogs",
+			Handler:       _DocksphinxService_FollowLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "docksphinx/v1/docksphinx.proto",
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"docksphinx/internal/certs"
+	"docksphinx/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+func certsCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "certs",
+		Usage: "Manage TLS certificates for remote access to docksphinxd",
+		Commands: []*cli.Command{
+			{
+				Name:  "init",
+				Usage: "Generate a local CA, a server certificate and a client certificate",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "dir",
+						Value: config.DefaultCertsDir(),
+						Usage: "directory the certificates are written to",
+					},
+					&cli.StringSliceFlag{
+						Name:  "host",
+						Usage: "DNS name or IP address clients use to reach docksphinxd (repeatable, default: localhost, loopback addresses and the hostname)",
+					},
+					&cli.DurationFlag{
+						Name:  "valid-for",
+						Value: certs.DefaultValidity,
+						Usage: "lifetime of the certificates",
+					},
+					&cli.BoolFlag{
+						Name:  "force",
+						Usage: "overwrite existing certificates",
+					},
+				},
+				Action: runCertsInit,
+			},
+		},
+	}
+}
+
+func runCertsInit(ctx context.Context, cmd *cli.Command) error {
+	hosts := cmd.StringSlice("host")
+	if len(hosts) == 0 {
+		hosts = []string{"localhost", "127.0.0.1", "::1"}
+		if name, err := os.Hostname(); err == nil && name != "" && name != "localhost" {
+			hosts = append(hosts, name)
+		}
+	}
+	files, err := certs.Init(certs.Options{
+		Dir:       cmd.String("dir"),
+		Hosts:     hosts,
+		ValidFor:  cmd.Duration("valid-for"),
+		Overwrite: cmd.Bool("force"),
+	})
+	if err != nil {
+		return fmt.Errorf("generate certificates: %w", err)
+	}
+
+	fmt.Printf("Generated certificates in %s for %v\n\n", cmd.String("dir"), hosts)
+	fmt.Printf("docksphinxd configuration:\n\n")
+	fmt.Printf("  grpc:\n    address: \"0.0.0.0:50051\"\n    tls:\n")
+	fmt.Printf("      cert_file: %s\n      key_file: %s\n      client_ca_file: %s\n\n", files.Server, files.ServerKey, files.CA)
+	fmt.Printf("Client:\n\n")
+	fmt.Printf("  docksphinx -a HOST:50051 --tls-ca %s --tls-cert %s --tls-key %s status\n\n", files.CA, files.Client, files.ClientKey)
+	fmt.Printf("Keep %s private; it can issue certificates docksphinxd trusts.\n", files.CAKey)
+	return nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	pb "docksphinx/api/docksphinx/v1"
+	"docksphinx/internal/config"
+	dsgrpc "docksphinx/internal/grpc"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// dial connects to docksphinxd at the address returned by daemonAddress
+func dial(cmd *cli.Command) (pb.DocksphinxServiceClient, func(), error) {
+	address := daemonAddress(cmd)
+	creds, err := transportCredentials(cmd, address)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if token := cmd.String("token"); token != "" {
+		secure := !dsgrpc.IsLocalAddress(address)
+		if secure && creds.Info().SecurityProtocol == "insecure" {
+			return nil, nil, fmt.Errorf("refusing to send --token in plaintext to %s (use --tls)", address)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken{token: token, secure: secure}))
+	}
+	conn, err := grpc.NewClient(address, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect to docksphinxd at %s: %w", address, err)
+	}
+	return pb.NewDocksphinxServiceClient(conn), func() { conn.Close() }, nil
+}
+
+// bearerToken sends a token in the authorization header of every call
+type bearerToken struct {
+	token  string
+	secure bool // Refuse to send the token without TLS
+}
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+// RequireTransportSecurity allows tokens in plaintext only over the Unix socket and loopback TCP
+func (t bearerToken) RequireTransportSecurity() bool {
+	return t.secure
+}
+
+// transportCredentials returns TLS credentials if requested by the --tls* flags.
+// The Unix socket never uses TLS.
+func transportCredentials(cmd *cli.Command, address string) (credentials.TransportCredentials, error) {
+	opts := dsgrpc.ClientTLSOptions{
+		CAFile:     cmd.String("tls-ca"),
+		CertFile:   cmd.String("tls-cert"),
+		KeyFile:    cmd.String("tls-key"),
+		ServerName: cmd.String("tls-server-name"),
+	}
+	useTLS := cmd.Bool("tls") || opts != dsgrpc.ClientTLSOptions{}
+	if !useTLS || strings.HasPrefix(address, "unix:") {
+		return insecure.NewCredentials(), nil
+	}
+	cfg, err := dsgrpc.ClientTLSConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
+// daemonAddress returns --address, or else the Unix socket docksphinxd listens on
+// according to the configuration ($DOCKSPHINX_CONFIG or the default file)
+func daemonAddress(cmd *cli.Command) string {
+	if address := cmd.String("address"); address != "" {
+		return address
+	}
+	socket := config.DefaultSocketPath()
+	if cfg, err := config.Load(os.Getenv("DOCKSPHINX_CONFIG")); err == nil {
+		socket = cfg.SocketPath()
+	}
+	return "unix://" + socket
+}
+
+// nameFilter compiles --filter; a nil filter matches everything
+func nameFilter(cmd *cli.Command) (*regexp.Regexp, error) {
+	pattern := cmd.String("filter")
+	if pattern == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	return re, nil
+}
+
+// matchName reports whether name passes the filter
+func matchName(re *regexp.Regexp, name string) bool {
+	return re == nil || re.MatchString(name)
+}
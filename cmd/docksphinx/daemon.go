@@ -0,0 +1,240 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"docksphinx/internal/config"
+	"docksphinx/internal/daemon"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	daemonStartTimeout = 5 * time.Second
+	daemonStopTimeout  = 15 * time.Second
+)
+
+func daemonCommand() *cli.Command {
+	flags := []cli.Flag{
+		&cli.StringFlag{
+			Name:    "config",
+			Aliases: []string{"c"},
+			Usage:   "docksphinxd configuration file",
+			Sources: cli.EnvVars("DOCKSPHINX_CONFIG"),
+		},
+		&cli.StringFlag{
+			Name:  "pid-file",
+			Usage: "PID file path (default: from config or " + config.DefaultPIDFile() + ")",
+		},
+	}
+	startFlags := append([]cli.Flag{
+		&cli.StringFlag{
+			Name:  "log-file",
+			Usage: "file receiving daemon output (default: from config or " + config.DefaultLogFile() + ")",
+		},
+		&cli.StringFlag{
+			Name:  "daemon-bin",
+			Usage: "path to the docksphinxd binary (default: next to docksphinx, then $PATH)",
+		},
+	}, flags...)
+
+	return &cli.Command{
+		Name:  "daemon",
+		Usage: "Manage the docksphinxd background process",
+		Commands: []*cli.Command{
+			{
+				Name:   "start",
+				Usage:  "Start docksphinxd in the background",
+				Flags:  startFlags,
+				Action: runDaemonStart,
+			},
+			{
+				Name:  "stop",
+				Usage: "Stop docksphinxd",
+				Flags: append([]cli.Flag{
+					&cli.BoolFlag{Name: "force", Usage: "send SIGKILL if the daemon does not stop in time"},
+				}, flags...),
+				Action: runDaemonStop,
+			},
+			{
+				Name:   "status",
+				Usage:  "Show whether docksphinxd is running",
+				Flags:  flags,
+				Action: runDaemonStatus,
+			},
+			{
+				Name:  "restart",
+				Usage: "Restart docksphinxd",
+				Flags: append([]cli.Flag{
+					&cli.BoolFlag{Name: "force", Usage: "send SIGKILL if the daemon does not stop in time"},
+				}, startFlags...),
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					if err := runDaemonStop(ctx, cmd); err != nil && !errors.Is(err, daemon.ErrNotRunning) {
+						return err
+					}
+					return runDaemonStart(ctx, cmd)
+				},
+			},
+		},
+	}
+}
+
+// daemonConfig loads the configuration used to locate the PID and log files.
+// Fails on platforms where docksphinxd cannot be managed as a background process.
+func daemonConfig(cmd *cli.Command) (*config.Config, string, error) {
+	if err := daemon.Supported(); err != nil {
+		return nil, "", err
+	}
+	cfg, err := config.Load(cmd.String("config"))
+	if err != nil {
+		return nil, "", err
+	}
+	pidFile := cfg.PIDFile()
+	if p := cmd.String("pid-file"); p != "" {
+		pidFile = p
+	}
+	return cfg, pidFile, nil
+}
+
+func runDaemonStart(ctx context.Context, cmd *cli.Command) error {
+	cfg, pidFile, err := daemonConfig(cmd)
+	if err != nil {
+		return err
+	}
+	if pid, err := daemon.ReadPIDFile(pidFile); err == nil {
+		return fmt.Errorf("docksphinxd is already running (pid %d)", pid)
+	}
+
+	bin, err := findDaemonBinary(cmd.String("daemon-bin"))
+	if err != nil {
+		return err
+	}
+
+	logFile := cmd.String("log-file")
+	if logFile == "" {
+		logFile = cfg.Log.File
+	}
+	if logFile == "" {
+		logFile = config.DefaultLogFile()
+	}
+	if err := os.MkdirAll(filepath.Dir(logFile), 0o700); err != nil {
+		return fmt.Errorf("create log directory: %w", err)
+	}
+	out, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("open log file: %w", err)
+	}
+	defer out.Close()
+
+	args := []string{"--pid-file", pidFile}
+	if c := cmd.String("config"); c != "" {
+		args = append(args, "--config", c)
+	}
+	proc := exec.Command(bin, args...)
+	proc.Stdout = out
+	proc.Stderr = out
+	// Detach from the terminal session so the daemon survives the shell
+	if err := daemon.Detach(proc); err != nil {
+		return err
+	}
+	if err := proc.Start(); err != nil {
+		return fmt.Errorf("start %s: %w", bin, err)
+	}
+
+	exited := make(chan error, 1)
+	go func() { exited <- proc.Wait() }()
+
+	deadline := time.After(daemonStartTimeout)
+	for {
+		select {
+		case err := <-exited:
+			return fmt.Errorf("docksphinxd exited during startup (%v), see %s", err, logFile)
+		case <-deadline:
+			return fmt.Errorf("docksphinxd did not write its pid file within %s, see %s", daemonStartTimeout, logFile)
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+			if pid, err := daemon.ReadPIDFile(pidFile); err == nil {
+				fmt.Printf("docksphinxd started (pid %d, log %s)\n", pid, logFile)
+				return nil
+			}
+		}
+	}
+}
+
+func runDaemonStop(ctx context.Context, cmd *cli.Command) error {
+	_, pidFile, err := daemonConfig(cmd)
+	if err != nil {
+		return err
+	}
+	pid, err := daemon.ReadPIDFile(pidFile)
+	if err != nil {
+		return err
+	}
+
+	if err := daemon.Terminate(pid); err != nil {
+		return fmt.Errorf("signal docksphinxd (pid %d): %w", pid, err)
+	}
+
+	deadline := time.After(daemonStopTimeout)
+	for daemon.ProcessAlive(pid) {
+		select {
+		case <-deadline:
+			if !cmd.Bool("force") {
+				return fmt.Errorf("docksphinxd (pid %d) did not stop within %s (use --force to kill it)", pid, daemonStopTimeout)
+			}
+			if err := daemon.Kill(pid); err != nil {
+				return fmt.Errorf("kill docksphinxd (pid %d): %w", pid, err)
+			}
+			_ = os.Remove(pidFile)
+			fmt.Printf("docksphinxd killed (pid %d)\n", pid)
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	fmt.Printf("docksphinxd stopped (pid %d)\n", pid)
+	return nil
+}
+
+func runDaemonStatus(ctx context.Context, cmd *cli.Command) error {
+	_, pidFile, err := daemonConfig(cmd)
+	if err != nil {
+		return err
+	}
+	pid, err := daemon.ReadPIDFile(pidFile)
+	if errors.Is(err, daemon.ErrNotRunning) {
+		fmt.Println("docksphinxd is not running")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("docksphinxd is running (pid %d, pid file %s)\n", pid, pidFile)
+	return nil
+}
+
+// findDaemonBinary locates docksphinxd: explicit path, next to this binary, then $PATH
+func findDaemonBinary(explicit string) (string, error) {
+	if explicit != "" {
+		return explicit, nil
+	}
+	if self, err := os.Executable(); err == nil {
+		candidate := filepath.Join(filepath.Dir(self), "docksphinxd")
+		if fi, err := os.Stat(candidate); err == nil && !fi.IsDir() {
+			return candidate, nil
+		}
+	}
+	path, err := exec.LookPath("docksphinxd")
+	if err != nil {
+		return "", fmt.Errorf("docksphinxd not found next to docksphinx or in $PATH (use --daemon-bin)")
+	}
+	return path, nil
+}
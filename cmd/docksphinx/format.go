@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	pb "docksphinx/api/docksphinx/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ANSI colors
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+	colorBlue   = "\033[34m"
+	colorGray   = "\033[90m"
+)
+
+// useColor reports whether stdout is a terminal and NO_COLOR is not set
+func useColor() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	fi, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
+// colorize wraps s in an ANSI color if enabled
+func colorize(enabled bool, color, s string) string {
+	if !enabled || color == "" {
+		return s
+	}
+	return color + s + colorReset
+}
+
+// eventSeverity returns the severity of an event: critical, warning or info
+func eventSeverity(ev *pb.Event) string {
+	switch ev.GetSeverity() {
+	case pb.Severity_SEVERITY_CRITICAL:
+		return "critical"
+	case pb.Severity_SEVERITY_WARNING:
+		return "warning"
+	case pb.Severity_SEVERITY_INFO:
+		return "info"
+	}
+	// Older daemons do not send a severity
+	if level := ev.GetData()["level"]; level != "" {
+		return level
+	}
+	switch ev.GetType() {
+	case "died", "impact":
+		return "critical"
+	case "stopped", "restarted":
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
+// parseSeverity converts a severity name given on the command line to its proto enum
+func parseSeverity(name string) (pb.Severity, error) {
+	switch name {
+	case "info":
+		return pb.Severity_SEVERITY_INFO, nil
+	case "warning":
+		return pb.Severity_SEVERITY_WARNING, nil
+	case "critical":
+		return pb.Severity_SEVERITY_CRITICAL, nil
+	}
+	return pb.Severity_SEVERITY_UNSPECIFIED, fmt.Errorf("invalid severity %q (want info, warning or critical)", name)
+}
+
+// severityColor returns the ANSI color for a severity
+func severityColor(severity string) string {
+	switch severity {
+	case "critical":
+		return colorRed
+	case "warning":
+		return colorYellow
+	default:
+		return colorGreen
+	}
+}
+
+// shortID shortens a container ID for display
+func shortID(id string) string {
+	if len(id) > 12 {
+		return id[:12]
+	}
+	return id
+}
+
+// formatBytes formats a byte count in human-readable units
+func formatBytes(n int64) string {
+	if n < 0 {
+		return "-"
+	}
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// truncate shortens s to at most n runes
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	if n <= 1 {
+		return string(r[:n])
+	}
+	return string(r[:n-1]) + "…"
+}
+
+// printJSON prints a proto message as a single JSON line
+func printJSON(m proto.Message) error {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+// indent prefixes every line of s
+func indent(s, prefix string) string {
+	return prefix + strings.ReplaceAll(s, "\n", "\n"+prefix)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/urfave/cli/v3"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cmd := &cli.Command{
+		Name:  "docksphinx",
+		Usage: "Monitor local Docker containers through docksphinxd",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "address",
+				Aliases: []string{"a"},
+				Usage:   "docksphinxd gRPC address, host:port or unix:///path (default: the Unix socket from the config)",
+				Sources: cli.EnvVars("DOCKSPHINX_ADDRESS"),
+			},
+			&cli.StringFlag{
+				Name:    "token",
+				Usage:   "bearer token, if docksphinxd requires authentication",
+				Sources: cli.EnvVars("DOCKSPHINX_TOKEN"),
+			},
+			&cli.BoolFlag{
+				Name:    "tls",
+				Usage:   "connect to a TCP address with TLS (implied by the other --tls-* flags)",
+				Sources: cli.EnvVars("DOCKSPHINX_TLS"),
+			},
+			&cli.StringFlag{
+				Name:    "tls-ca",
+				Usage:   "CA certificate verifying docksphinxd (default: system roots)",
+				Sources: cli.EnvVars("DOCKSPHINX_TLS_CA"),
+			},
+			&cli.StringFlag{
+				Name:    "tls-cert",
+				Usage:   "client certificate for mutual TLS",
+				Sources: cli.EnvVars("DOCKSPHINX_TLS_CERT"),
+			},
+			&cli.StringFlag{
+				Name:    "tls-key",
+				Usage:   "private key of the client certificate",
+				Sources: cli.EnvVars("DOCKSPHINX_TLS_KEY"),
+			},
+			&cli.StringFlag{
+				Name:    "tls-server-name",
+				Usage:   "name verified against the server certificate (default: host of --address)",
+				Sources: cli.EnvVars("DOCKSPHINX_TLS_SERVER_NAME"),
+			},
+		},
+		Commands: []*cli.Command{
+			snapshotCommand(),
+			tailCommand(),
+			statusCommand(),
+			metricsCommand(),
+			silenceCommand(),
+			tuiCommand(),
+			daemonCommand(),
+			certsCommand(),
+		},
+	}
+
+	if err := cmd.Run(ctx, os.Args); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// filterFlag restricts output to containers whose name matches a regex
+func filterFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:    "filter",
+		Aliases: []string{"f"},
+		Usage:   "only show containers whose name matches this regex",
+	}
+}
+
+// outputFlag selects the output format
+func outputFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:    "output",
+		Aliases: []string{"o"},
+		Value:   "table",
+		Usage:   "output format: table or json",
+		Validator: func(v string) error {
+			if v != "table" && v != "json" {
+				return fmt.Errorf("unknown output format %q (want table or json)", v)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "docksphinx/api/docksphinx/v1"
+	"github.com/urfave/cli/v3"
+)
+
+func metricsCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "metrics",
+		Usage:     "Query stored container metrics",
+		ArgsUsage: "[container]",
+		Flags: []cli.Flag{
+			outputFlag(),
+			&cli.DurationFlag{
+				Name:  "since",
+				Value: time.Hour,
+				Usage: "query metrics from this long ago (ignored if --from is set)",
+			},
+			&cli.StringFlag{
+				Name:  "from",
+				Usage: `start time ("2006-01-02 15:04", "15:04" for today, or RFC3339)`,
+			},
+			&cli.StringFlag{
+				Name:  "to",
+				Usage: "end time (default: now)",
+			},
+			&cli.DurationFlag{
+				Name:  "step",
+				Usage: "width of the returned points, e.g. 1m, 5m or 1h (default: resolution of the stored data)",
+			},
+			&cli.StringSliceFlag{
+				Name:    "metric",
+				Aliases: []string{"m"},
+				Usage:   "metric to show (repeatable, default: all)",
+			},
+		},
+		Action: runMetrics,
+	}
+}
+
+func runMetrics(ctx context.Context, cmd *cli.Command) error {
+	now := time.Now()
+	from := now.Add(-cmd.Duration("since"))
+	if v := cmd.String("from"); v != "" {
+		t, err := parseTime(v, now)
+		if err != nil {
+			return fmt.Errorf("invalid --from: %w", err)
+		}
+		from = t
+	}
+	var to time.Time
+	if v := cmd.String("to"); v != "" {
+		t, err := parseTime(v, now)
+		if err != nil {
+			return fmt.Errorf("invalid --to: %w", err)
+		}
+		to = t
+	}
+
+	client, closeConn, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	req := &pb.QueryMetricsRequest{
+		ContainerId: cmd.Args().First(),
+		Metrics:     cmd.StringSlice("metric"),
+		StartUnix:   from.Unix(),
+		StepSeconds: int64(cmd.Duration("step") / time.Second),
+	}
+	if !to.IsZero() {
+		req.EndUnix = to.Unix()
+	}
+	resp, err := client.QueryMetrics(ctx, req)
+	if err != nil {
+		return fmt.Errorf("query metrics: %w", err)
+	}
+
+	if cmd.String("output") == "json" {
+		return printJSON(resp)
+	}
+	return printMetricSeries(resp)
+}
+
+// parseTime parses an absolute time in the local time zone
+func parseTime(v string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02 15:04", v, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("15:04", v, time.Local); err == nil {
+		y, m, d := now.Date()
+		return time.Date(y, m, d, t.Hour(), t.Minute(), 0, 0, time.Local), nil
+	}
+	return time.Time{}, fmt.Errorf("unrecognized time %q", v)
+}
+
+// printMetricSeries prints every series as a table of min/avg/max per point
+func printMetricSeries(resp *pb.QueryMetricsResponse) error {
+	if len(resp.GetSeries()) == 0 {
+		fmt.Println("No metrics stored for this range")
+		return nil
+	}
+	step := "raw samples"
+	if resp.GetStepSeconds() > 0 {
+		step = (time.Duration(resp.GetStepSeconds()) * time.Second).String() + " steps"
+	}
+
+	for i, s := range resp.GetSeries() {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s %s (%s from %s data)\n", s.GetContainerName(), s.GetMetric(), step, resp.GetResolution())
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintln(w, "TIME\tMIN\tAVG\tMAX\t")
+		for _, p := range s.GetPoints() {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n",
+				time.Unix(p.GetTimestampUnix(), 0).Format(time.DateTime),
+				formatMetric(s.GetMetric(), p.GetMin()),
+				formatMetric(s.GetMetric(), p.GetAvg()),
+				formatMetric(s.GetMetric(), p.GetMax()),
+			)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatMetric formats a metric value in its unit
+func formatMetric(metric string, v float64) string {
+	switch {
+	case strings.HasSuffix(metric, "_percent"):
+		return fmt.Sprintf("%.2f%%", v)
+	case strings.HasSuffix(metric, "_rate"):
+		return formatBytes(int64(v)) + "/s"
+	case metric == "memory_usage":
+		return formatBytes(int64(v))
+	default:
+		return fmt.Sprintf("%g", v)
+	}
+}
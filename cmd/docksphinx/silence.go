@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "docksphinx/api/docksphinx/v1"
+	"github.com/urfave/cli/v3"
+)
+
+func silenceCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "silence",
+		Usage: "Manage notification silences",
+		Commands: []*cli.Command{
+			{
+				Name:  "add",
+				Usage: "Suppress notifications of matching events for a while",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "container",
+						Usage: "regex matched against the container name",
+					},
+					&cli.StringSliceFlag{
+						Name:  "label",
+						Usage: "container label that must match, as key=value (repeatable)",
+					},
+					&cli.StringSliceFlag{
+						Name:    "type",
+						Aliases: []string{"t"},
+						Usage:   "event type, e.g. stopped or cpu_threshold (repeatable)",
+					},
+					&cli.DurationFlag{
+						Name:    "duration",
+						Aliases: []string{"d"},
+						Value:   time.Hour,
+						Usage:   "how long the silence lasts",
+					},
+					&cli.StringFlag{
+						Name:    "comment",
+						Aliases: []string{"m"},
+						Usage:   "why the silence was created",
+					},
+				},
+				Action: runSilenceAdd,
+			},
+			{
+				Name:  "list",
+				Usage: "List silences",
+				Flags: []cli.Flag{
+					outputFlag(),
+					&cli.BoolFlag{
+						Name:  "all",
+						Usage: "include silences that expired within the last day",
+					},
+					&cli.BoolFlag{
+						Name:  "suppressed",
+						Usage: "also list recently suppressed events",
+					},
+				},
+				Action: runSilenceList,
+			},
+		},
+	}
+}
+
+func runSilenceAdd(ctx context.Context, cmd *cli.Command) error {
+	req := &pb.CreateSilenceRequest{
+		Container:       cmd.String("container"),
+		EventTypes:      cmd.StringSlice("type"),
+		Comment:         cmd.String("comment"),
+		DurationSeconds: int64(cmd.Duration("duration") / time.Second),
+	}
+	for _, l := range cmd.StringSlice("label") {
+		k, v, ok := strings.Cut(l, "=")
+		if !ok || k == "" {
+			return fmt.Errorf("invalid --label %q (want key=value)", l)
+		}
+		if req.Labels == nil {
+			req.Labels = make(map[string]string)
+		}
+		req.Labels[k] = v
+	}
+	if req.Container == "" && len(req.Labels) == 0 && len(req.EventTypes) == 0 {
+		return fmt.Errorf("at least one of --container, --label and --type is required")
+	}
+
+	client, closeConn, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	silence, err := client.CreateSilence(ctx, req)
+	if err != nil {
+		return fmt.Errorf("create silence: %w", err)
+	}
+	fmt.Printf("Created silence %s (%s), expires %s\n",
+		silence.GetId(), silenceMatchers(silence), time.Unix(silence.GetExpiresAtUnix(), 0).Format(time.DateTime))
+	return nil
+}
+
+func runSilenceList(ctx context.Context, cmd *cli.Command) error {
+	client, closeConn, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := client.ListSilences(ctx, &pb.ListSilencesRequest{
+		IncludeExpired:    cmd.Bool("all"),
+		IncludeSuppressed: cmd.Bool("suppressed"),
+	})
+	if err != nil {
+		return fmt.Errorf("list silences: %w", err)
+	}
+	if cmd.String("output") == "json" {
+		return printJSON(resp)
+	}
+
+	if len(resp.GetSilences()) == 0 {
+		fmt.Println("No silences")
+	} else {
+		now := time.Now()
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tMATCHERS\tEXPIRES\tSUPPRESSED\tCOMMENT")
+		for _, s := range resp.GetSilences() {
+			expires := time.Unix(s.GetExpiresAtUnix(), 0)
+			until := "in " + expires.Sub(now).Round(time.Minute).String()
+			if !expires.After(now) {
+				until = "expired"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s (%s)\t%d\t%s\n",
+				s.GetId(), silenceMatchers(s), expires.Format(time.DateTime), until, s.GetSuppressed(), s.GetComment())
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if !cmd.Bool("suppressed") {
+		return nil
+	}
+	fmt.Println()
+	if len(resp.GetSuppressed()) == 0 {
+		fmt.Println("No suppressed events")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tCONTAINER\tTYPE\tREASON\tMESSAGE")
+	for _, sup := range resp.GetSuppressed() {
+		ev := sup.GetEvent()
+		reason := sup.GetReason()
+		if sup.GetSilenceId() != "" {
+			reason += " (" + sup.GetSilenceId() + ")"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			time.Unix(ev.GetTimestampUnix(), 0).Format(time.DateTime),
+			ev.GetContainerName(), ev.GetType(), reason, truncate(ev.GetMessage(), 60))
+	}
+	return w.Flush()
+}
+
+// silenceMatchers describes the matchers of a silence
+func silenceMatchers(s *pb.Silence) string {
+	var parts []string
+	if s.GetContainer() != "" {
+		parts = append(parts, "container=~"+s.GetContainer())
+	}
+	keys := make([]string, 0, len(s.GetLabels()))
+	for k := range s.GetLabels() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		parts = append(parts, k+"="+s.GetLabels()[k])
+	}
+	if len(s.GetEventTypes()) > 0 {
+		parts = append(parts, "type="+strings.Join(s.GetEventTypes(), ","))
+	}
+	return strings.Join(parts, " ")
+}
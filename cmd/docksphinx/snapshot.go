@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	pb "docksphinx/api/docksphinx/v1"
+	"docksphinx/internal/tui"
+	"github.com/urfave/cli/v3"
+)
+
+func snapshotCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "snapshot",
+		Usage: "Show current container state and metrics once",
+		Flags: []cli.Flag{
+			filterFlag(),
+			outputFlag(),
+		},
+		Action: runSnapshot,
+	}
+}
+
+func runSnapshot(ctx context.Context, cmd *cli.Command) error {
+	filter, err := nameFilter(cmd)
+	if err != nil {
+		return err
+	}
+	client, closeConn, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	snap, err := client.GetSnapshot(ctx, &pb.GetSnapshotRequest{})
+	if err != nil {
+		return fmt.Errorf("get snapshot: %w", err)
+	}
+
+	// Apply the name filter to containers and their metrics
+	containers := make([]*pb.ContainerInfo, 0, len(snap.GetContainers()))
+	metrics := make(map[string]*pb.ContainerMetrics)
+	for _, c := range snap.GetContainers() {
+		if !matchName(filter, c.GetContainerName()) {
+			continue
+		}
+		containers = append(containers, c)
+		if m, ok := snap.GetMetrics()[c.GetContainerId()]; ok {
+			metrics[c.GetContainerId()] = m
+		}
+	}
+	sort.Slice(containers, func(i, j int) bool {
+		return containers[i].GetContainerName() < containers[j].GetContainerName()
+	})
+	snap.Containers = containers
+	snap.Metrics = metrics
+
+	if cmd.String("output") == "json" {
+		return printJSON(snap)
+	}
+
+	history, err := client.GetMetricHistory(ctx, &pb.GetMetricHistoryRequest{})
+	if err != nil {
+		return fmt.Errorf("get metric history: %w", err)
+	}
+	samples := make(map[string][]*pb.MetricSample)
+	for _, h := range history.GetHistories() {
+		samples[h.GetContainerId()] = h.GetSamples()
+	}
+	return printSnapshotTable(snap, samples)
+}
+
+// trendWidth is the number of samples shown in the sparkline columns
+const trendWidth = 20
+
+// printSnapshotTable prints containers and their metrics as a table, with sparklines of recent samples
+func printSnapshotTable(snap *pb.Snapshot, samples map[string][]*pb.MetricSample) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCONTAINER ID\tIMAGE\tSTATE\tCPU %\tCPU TREND\tMEM USAGE / LIMIT\tMEM %\tMEM TREND\tNET RX / TX\tSTATUS")
+	for _, c := range snap.GetContainers() {
+		m := snap.GetMetrics()[c.GetContainerId()]
+		cpu, mem, memPct, net := "-", "-", "-", "-"
+		cpuTrend, memTrend := sampleTrends(samples[c.GetContainerId()])
+		if m != nil && c.GetState() == "running" {
+			cpu = fmt.Sprintf("%.2f%%", m.GetCpuPercent())
+			mem = fmt.Sprintf("%s / %s", formatBytes(m.GetMemoryUsage()), formatBytes(m.GetMemoryLimit()))
+			memPct = fmt.Sprintf("%.2f%%", m.GetMemoryPercent())
+			net = fmt.Sprintf("%s / %s", formatBytes(m.GetNetworkRx()), formatBytes(m.GetNetworkTx()))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			c.GetContainerName(),
+			shortID(c.GetContainerId()),
+			truncate(c.GetImageName(), 30),
+			c.GetState(),
+			cpu, cpuTrend, mem, memPct, memTrend, net,
+			c.GetStatus(),
+		)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%d containers, %d images, %d networks, %d volumes (at %s)\n",
+		len(snap.GetContainers()), len(snap.GetImages()), len(snap.GetNetworks()), len(snap.GetVolumes()),
+		time.Unix(snap.GetAtUnix(), 0).Format(time.DateTime))
+	return nil
+}
+
+// sampleTrends renders CPU and memory sparklines of metric samples
+func sampleTrends(samples []*pb.MetricSample) (string, string) {
+	if len(samples) == 0 {
+		return "-", "-"
+	}
+	cpu := make([]float64, 0, len(samples))
+	mem := make([]float64, 0, len(samples))
+	for _, s := range samples {
+		cpu = append(cpu, s.GetCpuPercent())
+		mem = append(mem, float64(s.GetMemoryUsage()))
+	}
+	return tui.Sparkline(cpu, trendWidth), tui.Sparkline(mem, trendWidth)
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "docksphinx/api/docksphinx/v1"
+	"github.com/urfave/cli/v3"
+)
+
+func statusCommand() *cli.Command {
+	return &cli.Command{
+		Name:   "status",
+		Usage:  "Check whether docksphinxd is reachable and collecting",
+		Action: runStatus,
+	}
+}
+
+func runStatus(ctx context.Context, cmd *cli.Command) error {
+	client, closeConn, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	st, err := client.GetDaemonStatus(ctx, &pb.GetDaemonStatusRequest{})
+	if err != nil {
+		return fmt.Errorf("docksphinxd is not reachable at %s: %w", daemonAddress(cmd), err)
+	}
+	rtt := time.Since(start)
+	snap, err := client.GetSnapshot(ctx, &pb.GetSnapshotRequest{})
+	if err != nil {
+		return fmt.Errorf("get snapshot: %w", err)
+	}
+
+	fmt.Printf("docksphinxd %s is running at %s\n", st.GetVersion(), daemonAddress(cmd))
+	fmt.Printf("  response time: %s\n", rtt.Round(time.Millisecond))
+	fmt.Printf("  uptime:        %s (since %s)\n",
+		(time.Duration(st.GetUptimeSeconds()) * time.Second).String(),
+		time.Unix(st.GetStartedAtUnix(), 0).Format(time.DateTime))
+	configPath := st.GetConfigPath()
+	if configPath == "" {
+		configPath = "(defaults)"
+	}
+	fmt.Printf("  config:        %s\n", configPath)
+	if st.GetHealthy() {
+		fmt.Printf("  health:        serving\n")
+	} else {
+		fmt.Printf("  health:        not serving (%s)\n", st.GetHealthMessage())
+	}
+	if st.GetLastCollectionUnix() > 0 {
+		fmt.Printf("  last collect:  %s (took %s)\n",
+			time.Unix(st.GetLastCollectionUnix(), 0).Format(time.DateTime),
+			time.Duration(st.GetLastCollectionDurationSeconds()*float64(time.Second)).Round(time.Millisecond))
+	}
+	fmt.Printf("  containers:    %d\n", st.GetTrackedContainers())
+	fmt.Printf("  images:        %d\n", len(snap.GetImages()))
+	fmt.Printf("  networks:      %d\n", len(snap.GetNetworks()))
+	fmt.Printf("  volumes:       %d\n", len(snap.GetVolumes()))
+	if du := snap.GetDiskUsage(); du != nil {
+		fmt.Printf("  disk usage:    %s (collected %s)\n",
+			formatBytes(du.GetLayersSize()+du.GetContainersSize()+du.GetVolumesSize()+du.GetBuildCacheSize()),
+			time.Unix(du.GetCollectedAtUnix(), 0).Format(time.DateTime))
+	}
+	fmt.Printf("  subscribers:   %d\n", st.GetSubscribers())
+	fmt.Printf("  dropped:       %d events, %d to slow subscribers\n", st.GetDroppedEvents(), st.GetDroppedSubscriberEvents())
+
+	if !st.GetHealthy() {
+		return fmt.Errorf("docksphinxd is not collecting")
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	pb "docksphinx/api/docksphinx/v1"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func tailCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "tail",
+		Usage: "Stream events from docksphinxd",
+		Flags: []cli.Flag{
+			filterFlag(),
+			outputFlag(),
+			&cli.BoolFlag{
+				Name:    "verbose",
+				Aliases: []string{"v"},
+				Usage:   "show event data and captured container logs",
+			},
+			&cli.StringFlag{
+				Name:  "min-severity",
+				Value: "info",
+				Usage: "only show events at least this severe: info, warning or critical",
+			},
+		},
+		Action: runTail,
+	}
+}
+
+func runTail(ctx context.Context, cmd *cli.Command) error {
+	filter, err := nameFilter(cmd)
+	if err != nil {
+		return err
+	}
+	minSeverity, err := parseSeverity(cmd.String("min-severity"))
+	if err != nil {
+		return err
+	}
+	client, closeConn, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	stream, err := client.Stream(ctx, &pb.StreamRequest{MinSeverity: minSeverity})
+	if err != nil {
+		return fmt.Errorf("open stream: %w", err)
+	}
+
+	jsonOutput := cmd.String("output") == "json"
+	verbose := cmd.Bool("verbose")
+	color := useColor()
+
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("receive event: %w", err)
+		}
+		ev := update.GetEvent()
+		if ev == nil || !matchName(filter, ev.GetContainerName()) {
+			continue
+		}
+		if jsonOutput {
+			if err := printJSON(ev); err != nil {
+				return err
+			}
+			continue
+		}
+		printEvent(ev, verbose, color)
+	}
+}
+
+// printEvent prints an event as a single line, followed by data and logs if verbose
+func printEvent(ev *pb.Event, verbose, color bool) {
+	severity := eventSeverity(ev)
+	ts := time.Unix(ev.GetTimestampUnix(), 0).Format(time.TimeOnly)
+	name := ev.GetContainerName()
+	if name == "" {
+		name = "-"
+	}
+	fmt.Printf("%s %s %-14s %-20s %s\n",
+		colorize(color, colorGray, ts),
+		colorize(color, severityColor(severity), fmt.Sprintf("%-8s", severity)),
+		ev.GetType(),
+		name,
+		ev.GetMessage(),
+	)
+
+	if !verbose {
+		return
+	}
+
+	keys := make([]string, 0, len(ev.GetData()))
+	for k := range ev.GetData() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Println(indent(fmt.Sprintf("%s: %s", k, ev.GetData()[k]), "    "))
+	}
+
+	if len(ev.GetLogs()) > 0 {
+		fmt.Printf("    %s\n", colorize(color, colorBlue, fmt.Sprintf("last %d log lines:", len(ev.GetLogs()))))
+		for _, line := range ev.GetLogs() {
+			text := line.GetText()
+			if line.GetStream() == "stderr" {
+				text = colorize(color, colorRed, text)
+			}
+			fmt.Printf("    | %s\n", text)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+
+	"docksphinx/internal/tui"
+	"github.com/urfave/cli/v3"
+)
+
+func tuiCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "tui",
+		Usage: "Interactive terminal UI for containers, resources and events",
+		Flags: []cli.Flag{
+			&cli.DurationFlag{
+				Name:    "refresh",
+				Aliases: []string{"r"},
+				Value:   tui.DefaultRefreshInterval,
+				Usage:   "interval of metric updates (at least 1s)",
+			},
+		},
+		Action: runTUI,
+	}
+}
+
+func runTUI(ctx context.Context, cmd *cli.Command) error {
+	client, closeConn, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	return tui.Run(ctx, client, tui.Options{
+		Address:         daemonAddress(cmd),
+		RefreshInterval: cmd.Duration("refresh"),
+	})
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"docksphinx/internal/alert"
+	"docksphinx/internal/config"
+	"docksphinx/internal/daemon"
+	"docksphinx/internal/docker"
+	"docksphinx/internal/exporter"
+	"docksphinx/internal/grpc"
+	"docksphinx/internal/monitor"
+	"docksphinx/internal/notify"
+	"github.com/urfave/cli/v3"
+)
+
+// version is set at build time with -ldflags "-X main.version=..."
+var version = "dev"
+
+func main() {
+	cmd := &cli.Command{
+		Name:    "docksphinxd",
+		Usage:   "Docksphinx monitoring daemon",
+		Version: version,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "config",
+				Aliases: []string{"c"},
+				Usage:   "configuration file (default: " + config.DefaultConfigPath() + ")",
+				Sources: cli.EnvVars("DOCKSPHINX_CONFIG"),
+			},
+			&cli.StringFlag{
+				Name:  "pid-file",
+				Usage: "PID file path (default: " + config.DefaultPIDFile() + ")",
+			},
+			&cli.StringFlag{
+				Name:  "socket",
+				Usage: "gRPC Unix socket path (overrides grpc.socket)",
+			},
+			&cli.StringFlag{
+				Name:  "address",
+				Usage: "additional gRPC TCP listen address (overrides grpc.address)",
+			},
+		},
+		Action: run,
+	}
+
+	if err := cmd.Run(context.Background(), os.Args); err != nil {
+		log.Fatalf("docksphinxd: %v", err)
+	}
+}
+
+func run(ctx context.Context, cmd *cli.Command) error {
+	cfg, err := config.Load(cmd.String("config"))
+	if err != nil {
+		return err
+	}
+	if socket := cmd.String("socket"); socket != "" {
+		cfg.GRPC.Socket = socket
+	}
+	if addr := cmd.String("address"); addr != "" {
+		cfg.GRPC.Address = addr
+	}
+	// Flags may change what the file was validated with, e.g. expose tokens on a remote address
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	socketMode, err := cfg.SocketMode()
+	if err != nil {
+		return err
+	}
+	pidFile := cfg.PIDFile()
+	if p := cmd.String("pid-file"); p != "" {
+		pidFile = p
+	}
+
+	if cfg.Log.File != "" {
+		f, err := os.OpenFile(cfg.Log.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return fmt.Errorf("open log file: %w", err)
+		}
+		defer f.Close()
+		// The engine reports collection errors on stdout
+		os.Stdout = f
+		os.Stderr = f
+		log.SetOutput(f)
+	}
+
+	if err := daemon.WritePIDFile(pidFile); err != nil {
+		return err
+	}
+	defer func() {
+		if err := daemon.RemovePIDFile(pidFile); err != nil {
+			log.Printf("remove pid file: %v", err)
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	dockerClient, err := docker.NewClient()
+	if err != nil {
+		return err
+	}
+	defer dockerClient.Close()
+
+	if err := dockerClient.Ping(ctx); err != nil {
+		log.Printf("warning: %v (collection will be retried)", err)
+	}
+
+	engine, err := monitor.NewEngine(cfg.EngineConfig(), dockerClient)
+	if err != nil {
+		return fmt.Errorf("create engine: %w", err)
+	}
+	if err := engine.Start(); err != nil {
+		return fmt.Errorf("start engine: %w", err)
+	}
+	defer engine.Stop()
+
+	alerts, err := alert.NewManager(cfg.AlertOptions())
+	if err != nil {
+		return fmt.Errorf("alerts: %w", err)
+	}
+
+	auth, err := cfg.AuthOptions()
+	if err != nil {
+		return err
+	}
+	serverOpts := &grpc.ServerOptions{
+		Socket:      cfg.SocketPath(),
+		SocketMode:  socketMode,
+		SocketGroup: cfg.GRPC.SocketGroup,
+		Address:     cfg.GRPC.Address,
+		Auth:        auth,
+		AuditLog:    cfg.AuditLogFile(),
+		Alerts:      alerts,
+		Version:     version,
+		ConfigPath:  cfg.Path(),
+	}
+	if t := cfg.GRPC.TLS; t.Enabled() {
+		serverOpts.TLS = &grpc.TLSOptions{CertFile: t.CertFile, KeyFile: t.KeyFile, ClientCAFile: t.ClientCAFile}
+	} else if cfg.GRPC.Address != "" {
+		log.Printf("warning: serving plaintext gRPC on %s (configure grpc.tls to encrypt it)", cfg.GRPC.Address)
+	}
+	server, err := grpc.NewServer(serverOpts, engine)
+	if err != nil {
+		return err
+	}
+	defer server.Stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.Start()
+	}()
+
+	metricsErr := make(chan error, 1)
+	if cfg.Prometheus.Enabled {
+		metricsServer, err := exporter.NewPrometheusServer(exporter.PrometheusOptions{
+			Address: cfg.Prometheus.Address,
+			Path:    cfg.Prometheus.Path,
+		}, engine, server)
+		if err != nil {
+			return fmt.Errorf("prometheus exporter: %w", err)
+		}
+		defer metricsServer.Stop()
+		go func() {
+			metricsErr <- metricsServer.Start()
+		}()
+		log.Printf("serving prometheus metrics on http://%s%s", metricsServer.Addr(), cfg.Prometheus.Path)
+	}
+
+	if cfg.OTLP.Enabled {
+		otlpExporter, err := exporter.NewOTLPExporter(exporter.OTLPOptions{
+			Endpoint: cfg.OTLP.Endpoint,
+			Headers:  cfg.OTLP.Headers,
+			Interval: time.Duration(cfg.OTLP.Interval) * time.Second,
+		}, engine, server)
+		if err != nil {
+			return fmt.Errorf("otlp exporter: %w", err)
+		}
+		otlpExporter.Start()
+		defer otlpExporter.Stop()
+		log.Printf("exporting to OTLP collector at %s", cfg.OTLP.Endpoint)
+	}
+
+	if len(cfg.Notify.Webhooks)+len(cfg.Notify.Chats) > 0 || cfg.Notify.Desktop.Enabled {
+		routes, err := cfg.NotifyRoutes()
+		if err != nil {
+			return fmt.Errorf("notify: %w", err)
+		}
+		notifier := notify.NewNotifier(alerts, routes, notify.NewDeadLetter(cfg.DeadLetterFile()))
+		notifier.Start()
+		defer notifier.Stop()
+		log.Printf("sending notifications to %d sink(s)", len(routes))
+	}
+	// Stopped before the notifier, so that pending alert groups are still handed over
+	alerts.Start(server)
+	defer alerts.Stop()
+
+	log.Printf("docksphinxd started (pid %d, listening on %s)", os.Getpid(), strings.Join(server.Addrs(), ", "))
+
+	select {
+	case <-ctx.Done():
+		log.Printf("shutting down")
+	case err := <-serveErr:
+		if err != nil {
+			return fmt.Errorf("grpc server: %w", err)
+		}
+	case err := <-metricsErr:
+		if err != nil {
+			return fmt.Errorf("prometheus exporter: %w", err)
+		}
+	}
+
+	// Deferred calls run in reverse order: stop exporters and server, stop engine, close client, remove PID file
+	return nil
+}
@@ -0,0 +1,323 @@
+// Package alert reduces engine events to the alerts sent to notification sinks.
+//
+// The Manager sits between the event stream and the notifier: it drops events matched by
+// a silence, deduplicates repeated alerts and groups the events of a compose project that
+// arrive together (e.g. a whole stack stopping) into a single summary alert.
+// Suppressed events are recorded so that they can be inspected later.
+package alert
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"docksphinx/internal/event"
+	"docksphinx/internal/graph"
+)
+
+// Default alert settings
+const (
+	DefaultGroupWait     = 10 * time.Second
+	DefaultDedupWindow   = 5 * time.Minute
+	DefaultMaxSuppressed = 1000
+)
+
+// Suppression reasons
+const (
+	ReasonSilenced  = "silenced"
+	ReasonDuplicate = "duplicate"
+)
+
+// outputChanBuf is the buffer of subscriber channels
+const outputChanBuf = 256
+
+// EventSource provides event subscriptions (implemented by the gRPC server)
+type EventSource interface {
+	Subscribe() (<-chan *event.Event, func())
+}
+
+// Options configures the alert manager
+type Options struct {
+	// Events of a compose project (or a container outside compose) arriving within GroupWait
+	// of the first one are sent as one alert. Negative disables grouping (default: DefaultGroupWait).
+	GroupWait time.Duration
+	// An alert identical to one sent within DedupWindow is suppressed.
+	// Negative disables deduplication (default: DefaultDedupWindow).
+	DedupWindow time.Duration
+	// File silences are persisted to (empty: in memory only)
+	SilencesFile string
+	// Number of suppressed events remembered (default: DefaultMaxSuppressed)
+	MaxSuppressed int
+}
+
+// Suppression records an event that was not sent
+type Suppression struct {
+	Time      time.Time
+	Event     *event.Event
+	Reason    string // ReasonSilenced or ReasonDuplicate
+	SilenceID string // Set for ReasonSilenced
+}
+
+// Manager groups, deduplicates and silences events. It is an EventSource of the resulting alerts.
+type Manager struct {
+	opts     Options
+	silences *silenceStore
+
+	mu         sync.Mutex
+	groups     map[string]*group    // Key: group key
+	sent       map[string]time.Time // Key: dedup key, value: last time sent
+	suppressed []Suppression        // Oldest first
+	subs       map[chan *event.Event]struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// group is the events of a group waiting to be sent
+type group struct {
+	events []*event.Event
+	timer  *time.Timer
+}
+
+// NewManager creates an alert manager, loading persisted silences
+func NewManager(opts Options) (*Manager, error) {
+	if opts.GroupWait == 0 {
+		opts.GroupWait = DefaultGroupWait
+	}
+	if opts.DedupWindow == 0 {
+		opts.DedupWindow = DefaultDedupWindow
+	}
+	if opts.MaxSuppressed <= 0 {
+		opts.MaxSuppressed = DefaultMaxSuppressed
+	}
+	silences, err := loadSilences(opts.SilencesFile)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		opts:     opts,
+		silences: silences,
+		groups:   make(map[string]*group),
+		sent:     make(map[string]time.Time),
+		subs:     make(map[chan *event.Event]struct{}),
+		ctx:      ctx,
+		cancel:   cancel,
+	}, nil
+}
+
+// Start processes the events of source in the background
+func (m *Manager) Start(source EventSource) {
+	events, unsub := source.Subscribe()
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer unsub()
+		for {
+			select {
+			case <-m.ctx.Done():
+				return
+			case evt, ok := <-events:
+				if !ok {
+					return
+				}
+				m.Process(evt)
+			}
+		}
+	}()
+}
+
+// Stop stops processing, sends the pending groups and saves the silences
+func (m *Manager) Stop() {
+	m.cancel()
+	m.wg.Wait()
+
+	m.mu.Lock()
+	keys := make([]string, 0, len(m.groups))
+	for key, g := range m.groups {
+		g.timer.Stop()
+		keys = append(keys, key)
+	}
+	m.mu.Unlock()
+	for _, key := range keys {
+		m.flush(key)
+	}
+
+	if err := m.silences.close(); err != nil {
+		fmt.Printf("Error saving silences: %v\n", err)
+	}
+}
+
+// Subscribe returns a channel of alerts and a function to unsubscribe
+func (m *Manager) Subscribe() (<-chan *event.Event, func()) {
+	ch := make(chan *event.Event, outputChanBuf)
+	m.mu.Lock()
+	m.subs[ch] = struct{}{}
+	m.mu.Unlock()
+	return ch, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if _, ok := m.subs[ch]; ok {
+			delete(m.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// Process handles an event: silenced and duplicate events are recorded as suppressed,
+// others are sent right away or added to the group of their compose project.
+func (m *Manager) Process(evt *event.Event) {
+	now := time.Now()
+	if id, ok := m.silences.match(evt, now); ok {
+		m.suppress(Suppression{Time: now, Event: evt, Reason: ReasonSilenced, SilenceID: id})
+		return
+	}
+
+	m.mu.Lock()
+	if m.opts.DedupWindow > 0 {
+		key := dedupKey(evt)
+		if last, ok := m.sent[key]; ok && now.Sub(last) < m.opts.DedupWindow {
+			m.mu.Unlock()
+			m.suppress(Suppression{Time: now, Event: evt, Reason: ReasonDuplicate})
+			return
+		}
+		m.sent[key] = now
+		for k, t := range m.sent {
+			if now.Sub(t) >= m.opts.DedupWindow {
+				delete(m.sent, k)
+			}
+		}
+	}
+
+	if m.opts.GroupWait < 0 {
+		m.mu.Unlock()
+		m.send(evt)
+		return
+	}
+	key := groupKey(evt)
+	if g, ok := m.groups[key]; ok {
+		g.events = append(g.events, evt)
+		m.mu.Unlock()
+		return
+	}
+	m.groups[key] = &group{
+		events: []*event.Event{evt},
+		timer:  time.AfterFunc(m.opts.GroupWait, func() { m.flush(key) }),
+	}
+	m.mu.Unlock()
+}
+
+// flush sends the events of a group: a single event as is, several as a summary
+func (m *Manager) flush(key string) {
+	m.mu.Lock()
+	g, ok := m.groups[key]
+	delete(m.groups, key)
+	m.mu.Unlock()
+	if !ok {
+		return
+	}
+	if len(g.events) == 1 {
+		m.send(g.events[0])
+		return
+	}
+	m.send(summarize(key, g.events))
+}
+
+// send delivers an alert to all subscribers (non-blocking)
+func (m *Manager) send(evt *event.Event) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for ch := range m.subs {
+		select {
+		case ch <- evt:
+		default:
+			fmt.Printf("Warning: alert subscriber is full, dropping alert %s\n", evt.ID)
+		}
+	}
+}
+
+// suppress records a suppressed event
+func (m *Manager) suppress(s Suppression) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.suppressed = append(m.suppressed, s)
+	if len(m.suppressed) > m.opts.MaxSuppressed {
+		m.suppressed = m.suppressed[len(m.suppressed)-m.opts.MaxSuppressed:]
+	}
+}
+
+// Suppressed returns the recently suppressed events, oldest first
+func (m *Manager) Suppressed() []Suppression {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Suppression(nil), m.suppressed...)
+}
+
+// CreateSilence adds a silence lasting d from now. ID, CreatedAt and ExpiresAt are set by the manager.
+func (m *Manager) CreateSilence(s Silence, d time.Duration) (Silence, error) {
+	if d <= 0 {
+		return Silence{}, fmt.Errorf("silence duration must be positive")
+	}
+	s.CreatedAt = time.Now()
+	s.ExpiresAt = s.CreatedAt.Add(d)
+	return m.silences.add(s)
+}
+
+// ListSilences returns the silences, newest first. Expired silences are kept for a day.
+func (m *Manager) ListSilences(includeExpired bool) []Silence {
+	return m.silences.list(includeExpired, time.Now())
+}
+
+// groupKey returns the compose project of the event's container, or the container name
+func groupKey(evt *event.Event) string {
+	if project := evt.Labels[graph.LabelComposeProject]; project != "" {
+		return project
+	}
+	return evt.ContainerName
+}
+
+// dedupKey identifies identical alerts
+func dedupKey(evt *event.Event) string {
+	key := evt.ContainerName + "\x00" + string(evt.Type) + "\x00" + evt.Severity.String()
+	if p, ok := evt.Payload.(*event.LogMatchPayload); ok {
+		key += "\x00" + p.Pattern
+	}
+	return key
+}
+
+// summarize combines the events of a group into one alert with the type and severity of the most severe event.
+// The summary has no container; the group and its containers are carried in the payload and labels.
+func summarize(key string, events []*event.Event) *event.Event {
+	top := events[0]
+	for _, evt := range events[1:] {
+		if evt.Severity > top.Severity {
+			top = evt
+		}
+	}
+
+	names := make([]string, 0, len(events))
+	parts := make([]string, 0, len(events))
+	seen := make(map[string]bool)
+	for _, evt := range events {
+		parts = append(parts, fmt.Sprintf("%s %s", evt.ContainerName, evt.Type))
+		if !seen[evt.ContainerName] {
+			seen[evt.ContainerName] = true
+			names = append(names, evt.ContainerName)
+		}
+	}
+	sort.Strings(names)
+
+	summary := event.NewEvent(top.Type, "", "", "")
+	summary.Timestamp = events[0].Timestamp
+	summary.Message = fmt.Sprintf("%d events in %s: %s", len(events), key, strings.Join(parts, ", "))
+	summary.Severity = top.Severity
+	summary.SetPayload(&event.GroupPayload{Group: key, Count: len(events), Containers: names})
+	if project := top.Labels[graph.LabelComposeProject]; project != "" {
+		summary.Labels = map[string]string{graph.LabelComposeProject: project}
+	}
+	return summary
+}
@@ -0,0 +1,127 @@
+package alert
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"docksphinx/internal/event"
+	"docksphinx/internal/graph"
+)
+
+func composeEvent(t event.EventType, name, project string) *event.Event {
+	evt := event.NewEvent(t, name+"-id", name, "image")
+	if t == event.EventTypeDied {
+		evt.Severity = event.SeverityCritical
+	}
+	if project != "" {
+		evt.Labels = map[string]string{graph.LabelComposeProject: project, graph.LabelComposeService: name}
+	}
+	return evt
+}
+
+// receive waits for the next alert
+func receive(t *testing.T, ch <-chan *event.Event) *event.Event {
+	t.Helper()
+	select {
+	case evt := <-ch:
+		return evt
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for alert")
+		return nil
+	}
+}
+
+func TestGroupAndDedup(t *testing.T) {
+	m, err := NewManager(Options{GroupWait: 50 * time.Millisecond, DedupWindow: time.Minute})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+	alerts, unsub := m.Subscribe()
+	defer unsub()
+
+	m.Process(composeEvent(event.EventTypeStopped, "web", "shop"))
+	m.Process(composeEvent(event.EventTypeDied, "db", "shop"))
+	m.Process(composeEvent(event.EventTypeStopped, "api", "shop"))
+	m.Process(composeEvent(event.EventTypeStopped, "web", "shop")) // Duplicate
+	m.Process(composeEvent(event.EventTypeStopped, "tool", ""))
+
+	got := map[string]*event.Event{}
+	for range 2 {
+		evt := receive(t, alerts)
+		if p, ok := evt.Payload.(*event.GroupPayload); ok {
+			if evt.ContainerName != "" {
+				t.Errorf("Expected no container name in the summary, got %s", evt.ContainerName)
+			}
+			got[p.Group] = evt
+			continue
+		}
+		got[evt.ContainerName] = evt
+	}
+
+	summary := got["shop"]
+	if summary == nil {
+		t.Fatalf("Expected a summary alert for project shop, got %v", got)
+	}
+	if summary.Type != event.EventTypeDied || summary.Severity != event.SeverityCritical {
+		t.Errorf("Expected summary with the most severe event, got %s/%s", summary.Type, summary.Severity)
+	}
+	if summary.Data["grouped_count"] != 3 || summary.Data["containers"] != "api,db,web" {
+		t.Errorf("Unexpected summary data: %v", summary.Data)
+	}
+	if !strings.HasPrefix(summary.Message, "3 events in shop: web stopped, db died") {
+		t.Errorf("Unexpected summary message: %s", summary.Message)
+	}
+	if got["tool"] == nil || got["tool"].Type != event.EventTypeStopped {
+		t.Errorf("Expected the single event of tool as is, got %v", got["tool"])
+	}
+
+	sup := m.Suppressed()
+	if len(sup) != 1 || sup[0].Reason != ReasonDuplicate || sup[0].Event.ContainerName != "web" {
+		t.Errorf("Unexpected suppressed events: %+v", sup)
+	}
+}
+
+func TestSilences(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "silences.json")
+	m, err := NewManager(Options{GroupWait: -1, DedupWindow: -1, SilencesFile: path})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+	alerts, unsub := m.Subscribe()
+	defer unsub()
+
+	if _, err := m.CreateSilence(Silence{}, time.Hour); err == nil {
+		t.Error("Expected error for silence without matchers")
+	}
+	s, err := m.CreateSilence(Silence{
+		Labels:     map[string]string{graph.LabelComposeProject: "shop"},
+		EventTypes: []event.EventType{event.EventTypeStopped},
+		Comment:    "deploying",
+	}, time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to create silence: %v", err)
+	}
+
+	m.Process(composeEvent(event.EventTypeStopped, "web", "shop"))
+	m.Process(composeEvent(event.EventTypeDied, "db", "shop"))
+	if evt := receive(t, alerts); evt.ContainerName != "db" {
+		t.Errorf("Expected only the died event, got %s", evt.ContainerName)
+	}
+	sup := m.Suppressed()
+	if len(sup) != 1 || sup[0].Reason != ReasonSilenced || sup[0].SilenceID != s.ID {
+		t.Errorf("Unexpected suppressed events: %+v", sup)
+	}
+	m.Stop()
+
+	// Silences and their counters survive a restart
+	m, err = NewManager(Options{SilencesFile: path})
+	if err != nil {
+		t.Fatalf("Failed to reload manager: %v", err)
+	}
+	list := m.ListSilences(false)
+	if len(list) != 1 || list[0].ID != s.ID || list[0].Suppressed != 1 || list[0].Comment != "deploying" {
+		t.Errorf("Unexpected silences after reload: %+v", list)
+	}
+}
@@ -0,0 +1,203 @@
+package alert
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"docksphinx/internal/event"
+)
+
+// expiredSilenceRetention is how long expired silences are kept for listing
+const expiredSilenceRetention = 24 * time.Hour
+
+// Silence suppresses notifications of matching events until it expires.
+// Empty matchers match everything, but a silence needs at least one matcher.
+type Silence struct {
+	ID         string            `json:"id"`
+	Container  string            `json:"container,omitempty"` // Regex matched against the container name
+	Labels     map[string]string `json:"labels,omitempty"`    // Container labels that must all be equal
+	EventTypes []event.EventType `json:"event_types,omitempty"`
+	Comment    string            `json:"comment,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+	ExpiresAt  time.Time         `json:"expires_at"`
+	Suppressed uint64            `json:"suppressed"` // Number of events suppressed
+
+	container *regexp.Regexp
+}
+
+// Active reports whether the silence has not expired at now
+func (s *Silence) Active(now time.Time) bool {
+	return now.Before(s.ExpiresAt)
+}
+
+// Matches reports whether the event matches the matchers of the silence
+func (s *Silence) Matches(evt *event.Event) bool {
+	if s.container != nil && !s.container.MatchString(evt.ContainerName) {
+		return false
+	}
+	for k, v := range s.Labels {
+		if evt.Labels[k] != v {
+			return false
+		}
+	}
+	if len(s.EventTypes) > 0 {
+		for _, t := range s.EventTypes {
+			if t == evt.Type {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// compile validates the matchers and compiles the container pattern
+func (s *Silence) compile() error {
+	if s.Container == "" && len(s.Labels) == 0 && len(s.EventTypes) == 0 {
+		return fmt.Errorf("silence needs a container, label or event type matcher")
+	}
+	if s.Container != "" {
+		re, err := regexp.Compile(s.Container)
+		if err != nil {
+			return fmt.Errorf("invalid container pattern: %w", err)
+		}
+		s.container = re
+	}
+	return nil
+}
+
+// silenceStore keeps silences, persisted to a JSON file if path is set
+type silenceStore struct {
+	mu       sync.Mutex
+	path     string
+	silences []*Silence // Oldest first
+}
+
+// loadSilences reads the silences file. A missing file yields an empty store.
+func loadSilences(path string) (*silenceStore, error) {
+	st := &silenceStore{path: path}
+	if path == "" {
+		return st, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return st, nil
+		}
+		return nil, fmt.Errorf("read silences: %w", err)
+	}
+	var silences []*Silence
+	if err := json.Unmarshal(data, &silences); err != nil {
+		return nil, fmt.Errorf("parse silences %s: %w", path, err)
+	}
+	now := time.Now()
+	for _, s := range silences {
+		if now.Sub(s.ExpiresAt) > expiredSilenceRetention || s.compile() != nil {
+			continue
+		}
+		st.silences = append(st.silences, s)
+	}
+	return st, nil
+}
+
+// add validates and stores a new silence
+func (st *silenceStore) add(s Silence) (Silence, error) {
+	if err := s.compile(); err != nil {
+		return Silence{}, err
+	}
+	if !s.ExpiresAt.After(s.CreatedAt) {
+		return Silence{}, fmt.Errorf("silence must expire after it is created")
+	}
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return Silence{}, err
+	}
+	s.ID = hex.EncodeToString(id)
+	s.Suppressed = 0
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.prune(s.CreatedAt)
+	st.silences = append(st.silences, &s)
+	if err := st.save(); err != nil {
+		st.silences = st.silences[:len(st.silences)-1]
+		return Silence{}, err
+	}
+	return s, nil
+}
+
+// match returns the active silence matching the event and counts the suppression
+func (st *silenceStore) match(evt *event.Event, now time.Time) (string, bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	for _, s := range st.silences {
+		if s.Active(now) && s.Matches(evt) {
+			s.Suppressed++
+			return s.ID, true
+		}
+	}
+	return "", false
+}
+
+// list returns copies of the silences, newest first
+func (st *silenceStore) list(includeExpired bool, now time.Time) []Silence {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	var result []Silence
+	for _, s := range st.silences {
+		if includeExpired || s.Active(now) {
+			result = append(result, *s)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+	return result
+}
+
+// prune drops silences expired for longer than expiredSilenceRetention. Caller holds mu.
+func (st *silenceStore) prune(now time.Time) {
+	kept := st.silences[:0]
+	for _, s := range st.silences {
+		if now.Sub(s.ExpiresAt) <= expiredSilenceRetention {
+			kept = append(kept, s)
+		}
+	}
+	st.silences = kept
+}
+
+// save writes the silences file atomically. Caller holds mu.
+func (st *silenceStore) save() error {
+	if st.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(st.silences, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(st.path), 0o700); err != nil {
+		return fmt.Errorf("create silences directory: %w", err)
+	}
+	tmp := st.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write silences: %w", err)
+	}
+	if err := os.Rename(tmp, st.path); err != nil {
+		return fmt.Errorf("write silences: %w", err)
+	}
+	return nil
+}
+
+// close saves the suppression counters
+func (st *silenceStore) close() error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.save()
+}
@@ -0,0 +1,191 @@
+// Package certs generates a private CA with server and client certificates
+// for serving the docksphinxd API over TLS in self-hosted setups.
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// File names written by Init
+const (
+	CAFile        = "ca.pem"
+	CAKeyFile     = "ca-key.pem"
+	ServerFile    = "server.pem"
+	ServerKeyFile = "server-key.pem"
+	ClientFile    = "client.pem"
+	ClientKeyFile = "client-key.pem"
+)
+
+// DefaultValidity is the lifetime of generated certificates
+const DefaultValidity = 2 * 365 * 24 * time.Hour
+
+// Options configures certificate generation
+type Options struct {
+	Dir       string        // Output directory, created if missing
+	Hosts     []string      // DNS names and IP addresses of the server certificate
+	ValidFor  time.Duration // Lifetime of all certificates (default: DefaultValidity)
+	Overwrite bool          // Replace existing files
+}
+
+// Files are the paths of the generated files
+type Files struct {
+	CA, CAKey         string
+	Server, ServerKey string
+	Client, ClientKey string
+}
+
+// Paths returns the paths of the files Init writes to dir
+func Paths(dir string) Files {
+	return Files{
+		CA:        filepath.Join(dir, CAFile),
+		CAKey:     filepath.Join(dir, CAKeyFile),
+		Server:    filepath.Join(dir, ServerFile),
+		ServerKey: filepath.Join(dir, ServerKeyFile),
+		Client:    filepath.Join(dir, ClientFile),
+		ClientKey: filepath.Join(dir, ClientKeyFile),
+	}
+}
+
+// Init generates a CA and a server and a client certificate signed by it
+func Init(opts Options) (Files, error) {
+	files := Paths(opts.Dir)
+	if len(opts.Hosts) == 0 {
+		return files, fmt.Errorf("at least one server host is required")
+	}
+	if opts.ValidFor <= 0 {
+		opts.ValidFor = DefaultValidity
+	}
+	if !opts.Overwrite {
+		for _, p := range []string{files.CA, files.CAKey, files.Server, files.ServerKey, files.Client, files.ClientKey} {
+			if _, err := os.Stat(p); err == nil {
+				return files, fmt.Errorf("%s already exists", p)
+			} else if !errors.Is(err, os.ErrNotExist) {
+				return files, err
+			}
+		}
+	}
+	if err := os.MkdirAll(opts.Dir, 0o700); err != nil {
+		return files, fmt.Errorf("create certificate directory: %w", err)
+	}
+
+	now := time.Now()
+	notAfter := now.Add(opts.ValidFor)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return files, err
+	}
+	caTemplate := &x509.Certificate{
+		Subject:               pkix.Name{Organization: []string{"docksphinx"}, CommonName: "docksphinx CA"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	caDER, caCert, err := sign(caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return files, fmt.Errorf("create CA certificate: %w", err)
+	}
+	if err := writePEM(files.CA, "CERTIFICATE", caDER, 0o644); err != nil {
+		return files, err
+	}
+	if err := writeKey(files.CAKey, caKey); err != nil {
+		return files, err
+	}
+
+	server := &x509.Certificate{
+		Subject:     pkix.Name{Organization: []string{"docksphinx"}, CommonName: "docksphinxd"},
+		NotBefore:   now.Add(-time.Hour),
+		NotAfter:    notAfter,
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	for _, h := range opts.Hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			server.IPAddresses = append(server.IPAddresses, ip)
+		} else {
+			server.DNSNames = append(server.DNSNames, h)
+		}
+	}
+	if err := issue(server, caCert, caKey, files.Server, files.ServerKey); err != nil {
+		return files, fmt.Errorf("create server certificate: %w", err)
+	}
+
+	client := &x509.Certificate{
+		Subject:     pkix.Name{Organization: []string{"docksphinx"}, CommonName: "docksphinx client"},
+		NotBefore:   now.Add(-time.Hour),
+		NotAfter:    notAfter,
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if err := issue(client, caCert, caKey, files.Client, files.ClientKey); err != nil {
+		return files, fmt.Errorf("create client certificate: %w", err)
+	}
+	return files, nil
+}
+
+// issue creates a key pair, signs template with the CA and writes both files
+func issue(template, ca *x509.Certificate, caKey *ecdsa.PrivateKey, certPath, keyPath string) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	der, _, err := sign(template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return err
+	}
+	if err := writePEM(certPath, "CERTIFICATE", der, 0o644); err != nil {
+		return err
+	}
+	return writeKey(keyPath, key)
+}
+
+// sign creates a certificate with a random serial number
+func sign(template, parent *x509.Certificate, pub *ecdsa.PublicKey, signer *ecdsa.PrivateKey) ([]byte, *x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	template.SerialNumber = serial
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, signer)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+	return der, cert, nil
+}
+
+// writeKey writes a private key readable by the owner only
+func writeKey(path string, key *ecdsa.PrivateKey) error {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return err
+	}
+	return writePEM(path, "PRIVATE KEY", der, 0o600)
+}
+
+func writePEM(path, blockType string, der []byte, mode os.FileMode) error {
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(path, data, mode); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	// WriteFile keeps the mode of an existing file
+	return os.Chmod(path, mode)
+}
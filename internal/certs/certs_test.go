@@ -0,0 +1,73 @@
+package certs
+
+import (
+	"crypto/tls"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"docksphinx/internal/grpc"
+)
+
+func TestInit(t *testing.T) {
+	dir := t.TempDir()
+	files, err := Init(Options{Dir: dir, Hosts: []string{"localhost", "127.0.0.1"}})
+	if err != nil {
+		t.Fatalf("Failed to generate certificates: %v", err)
+	}
+	if fi, err := os.Stat(files.CAKey); err != nil || fi.Mode().Perm() != 0o600 {
+		t.Errorf("Expected CA key with mode 0600, got %v (%v)", fi.Mode(), err)
+	}
+	if _, err := Init(Options{Dir: dir, Hosts: []string{"localhost"}}); err == nil {
+		t.Error("Expected error when certificates already exist")
+	}
+
+	serverConfig, err := grpc.ServerTLSConfig(grpc.TLSOptions{CertFile: files.Server, KeyFile: files.ServerKey, ClientCAFile: files.CA})
+	if err != nil {
+		t.Fatalf("Failed to load server config: %v", err)
+	}
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	handshake := func(opts grpc.ClientTLSOptions) error {
+		cfg, err := grpc.ClientTLSConfig(opts)
+		if err != nil {
+			return err
+		}
+		conn, err := tls.Dial("tcp", lis.Addr().String(), cfg)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		// With TLS 1.3 a rejected client certificate is reported on the first read
+		_, err = conn.Read(make([]byte, 1))
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+
+	if err := handshake(grpc.ClientTLSOptions{CAFile: files.CA, CertFile: files.Client, KeyFile: files.ClientKey}); err != nil {
+		t.Errorf("Expected mutual TLS to succeed: %v", err)
+	}
+	if err := handshake(grpc.ClientTLSOptions{CAFile: files.CA}); err == nil {
+		t.Error("Expected handshake without client certificate to fail")
+	}
+	if err := handshake(grpc.ClientTLSOptions{CAFile: files.CA, CertFile: files.Client, KeyFile: files.ClientKey, ServerName: "example.com"}); err == nil {
+		t.Error("Expected handshake with a name not in the server certificate to fail")
+	}
+}
@@ -0,0 +1,833 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"docksphinx/internal/alert"
+	"docksphinx/internal/event"
+	"docksphinx/internal/grpc"
+	"docksphinx/internal/monitor"
+	"docksphinx/internal/notify"
+	"docksphinx/internal/tsdb"
+	"gopkg.in/yaml.v3"
+)
+
+// MinInterval is the lower bound of the collection interval.
+// Collecting more often makes the monitor itself a noticeable load.
+const MinInterval = 1 * time.Second
+
+// Config represents the docksphinx configuration file (see configs/docksphinx.yaml.example)
+type Config struct {
+	Monitor    MonitorConfig    `yaml:"monitor"`
+	LogWatch   LogWatchConfig   `yaml:"log_watch"`
+	GRPC       GRPCConfig       `yaml:"grpc"`
+	Log        LogConfig        `yaml:"log"`
+	Event      EventConfig      `yaml:"event"`
+	Daemon     DaemonConfig     `yaml:"daemon"`
+	Storage    StorageConfig    `yaml:"storage"`
+	Prometheus PrometheusConfig `yaml:"prometheus"`
+	OTLP       OTLPConfig       `yaml:"otlp"`
+	Notify     NotifyConfig     `yaml:"notify"`
+
+	path string // File the configuration was loaded from
+}
+
+// MonitorConfig represents collection and detection settings. Intervals are in seconds.
+type MonitorConfig struct {
+	Interval          int              `yaml:"interval"`
+	ResourceInterval  int              `yaml:"resource_interval"`
+	DiskUsageInterval int              `yaml:"disk_usage_interval"`
+	ImpactWindow      int              `yaml:"impact_window"`
+	HistorySize       int              `yaml:"history_size"`
+	Filters           FiltersConfig    `yaml:"filters"`
+	Thresholds        ThresholdsConfig `yaml:"thresholds"`
+}
+
+// FiltersConfig selects monitored containers. Patterns in a list are OR-ed.
+type FiltersConfig struct {
+	ContainerNames []string `yaml:"container_names"`
+	ImageNames     []string `yaml:"image_names"`
+}
+
+// ThresholdsConfig represents resource thresholds
+type ThresholdsConfig struct {
+	CPU    PercentThreshold `yaml:"cpu"`
+	Memory PercentThreshold `yaml:"memory"`
+	Volume VolumeThreshold  `yaml:"volume"`
+}
+
+// PercentThreshold represents a percentage threshold with consecutive detection
+type PercentThreshold struct {
+	Warning          float64 `yaml:"warning"`
+	Critical         float64 `yaml:"critical"`
+	ConsecutiveCount int     `yaml:"consecutive_count"`
+}
+
+// VolumeThreshold represents a volume growth threshold
+type VolumeThreshold struct {
+	GrowthBytes   int64   `yaml:"growth_bytes"`
+	GrowthPercent float64 `yaml:"growth_percent"`
+}
+
+// LogWatchConfig represents log pattern detection settings
+type LogWatchConfig struct {
+	ContainerNamePattern string          `yaml:"container_name_pattern"`
+	Patterns             []PatternConfig `yaml:"patterns"`
+}
+
+// PatternConfig represents a log pattern. Durations are in seconds.
+type PatternConfig struct {
+	Name         string `yaml:"name"`
+	Regex        string `yaml:"regex"`
+	Stream       string `yaml:"stream"`
+	Level        string `yaml:"level"`
+	MinCount     int    `yaml:"min_count"`
+	Window       int    `yaml:"window"`
+	RateLimit    int    `yaml:"rate_limit"`
+	ContextLines int    `yaml:"context_lines"`
+}
+
+// GRPCConfig represents gRPC server settings
+type GRPCConfig struct {
+	Socket      string     `yaml:"socket"`       // Empty: DefaultSocketPath()
+	SocketMode  string     `yaml:"socket_mode"`  // Octal permissions, e.g. "0660"
+	SocketGroup string     `yaml:"socket_group"` // Group name or GID (empty: primary group of the daemon user)
+	Address     string     `yaml:"address"`      // TCP address (empty: TCP disabled)
+	TLS         TLSConfig  `yaml:"tls"`          // TLS of the TCP listener
+	Auth        AuthConfig `yaml:"auth"`
+	Timeout     int        `yaml:"timeout"`
+}
+
+// AuthConfig represents bearer-token authentication. Authentication is enabled when tokens are defined.
+type AuthConfig struct {
+	Tokens     []TokenConfig `yaml:"tokens"`
+	SocketRole string        `yaml:"socket_role"` // Role of Unix socket clients without a token (empty: token required)
+	AuditLog   string        `yaml:"audit_log"`   // Empty: DefaultAuditLogFile()
+}
+
+// TokenConfig represents a bearer token and the role it grants
+type TokenConfig struct {
+	Name      string `yaml:"name"`
+	Token     string `yaml:"token"`
+	TokenFile string `yaml:"token_file"` // Read instead of token, surrounding whitespace is ignored
+	Role      string `yaml:"role"`       // read-only or admin
+}
+
+// Value returns the token, reading token_file if set
+func (t TokenConfig) Value() (string, error) {
+	if t.TokenFile == "" {
+		return t.Token, nil
+	}
+	data, err := os.ReadFile(t.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("read token %s: %w", t.Name, err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file of %s is empty", t.Name)
+	}
+	return token, nil
+}
+
+// TLSConfig represents TLS settings of the TCP listener
+type TLSConfig struct {
+	CertFile     string `yaml:"cert_file"`
+	KeyFile      string `yaml:"key_file"`
+	ClientCAFile string `yaml:"client_ca_file"` // Requires client certificates signed by this CA
+}
+
+// Enabled reports whether a server certificate is configured
+func (t TLSConfig) Enabled() bool {
+	return t.CertFile != ""
+}
+
+// LogConfig represents daemon log settings
+type LogConfig struct {
+	Level string `yaml:"level"`
+	File  string `yaml:"file"` // Empty: standard output
+}
+
+// EventConfig represents event history settings
+type EventConfig struct {
+	MaxHistory int              `yaml:"max_history"`
+	LogCapture LogCaptureConfig `yaml:"log_capture"`
+}
+
+// LogCaptureConfig represents log capture settings for failure events
+type LogCaptureConfig struct {
+	Lines      int      `yaml:"lines"`
+	EventTypes []string `yaml:"event_types"`
+}
+
+// DaemonConfig represents docksphinxd process settings
+type DaemonConfig struct {
+	PIDFile string `yaml:"pid_file"` // Empty: DefaultPIDFile()
+}
+
+// StorageConfig represents persistent metric storage settings. Retentions are in seconds.
+type StorageConfig struct {
+	Enabled   bool            `yaml:"enabled"`
+	Path      string          `yaml:"path"` // Empty: DefaultMetricsDir()
+	Retention RetentionConfig `yaml:"retention"`
+	MaxSizeMB int64           `yaml:"max_size_mb"` // 0: unlimited
+}
+
+// RetentionConfig represents the retention per resolution
+type RetentionConfig struct {
+	Raw     int `yaml:"raw"`
+	Minute  int `yaml:"1m"`
+	FiveMin int `yaml:"5m"`
+	Hour    int `yaml:"1h"`
+}
+
+// PrometheusConfig represents the Prometheus /metrics endpoint settings
+type PrometheusConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Address string `yaml:"address"`
+	Path    string `yaml:"path"`
+}
+
+// OTLPConfig represents the OpenTelemetry (OTLP/HTTP) export settings
+type OTLPConfig struct {
+	Enabled  bool              `yaml:"enabled"`
+	Endpoint string            `yaml:"endpoint"`
+	Headers  map[string]string `yaml:"headers"`
+	Interval int               `yaml:"interval"` // Metric export interval in seconds
+}
+
+// NotifyConfig represents event notification settings. GroupWait and DedupWindow are in seconds (0 disables).
+type NotifyConfig struct {
+	DeadLetterFile string          `yaml:"dead_letter_file"` // Empty: DefaultDeadLetterFile()
+	SilencesFile   string          `yaml:"silences_file"`    // Empty: DefaultSilencesFile()
+	GroupWait      int             `yaml:"group_wait"`
+	DedupWindow    int             `yaml:"dedup_window"`
+	Webhooks       []WebhookConfig `yaml:"webhooks"`
+	Chats          []ChatConfig    `yaml:"chats"`
+	Desktop        DesktopConfig   `yaml:"desktop"`
+}
+
+// RouteConfig represents the event filter and delivery settings of a sink. Timeout is in seconds.
+type RouteConfig struct {
+	EventTypes     []string `yaml:"event_types"`
+	ContainerNames []string `yaml:"container_names"`
+	MinSeverity    string   `yaml:"min_severity"`
+	MinLevel       string   `yaml:"min_level"` // Deprecated: alias of min_severity, takes precedence if set
+	MaxAttempts    int      `yaml:"max_attempts"`
+	Timeout        int      `yaml:"timeout"`
+}
+
+// WebhookConfig represents a generic webhook sink
+type WebhookConfig struct {
+	Name         string            `yaml:"name"`
+	URL          string            `yaml:"url"`
+	Headers      map[string]string `yaml:"headers"`
+	BodyTemplate string            `yaml:"body_template"`
+	RouteConfig  `yaml:",inline"`
+}
+
+// ChatConfig represents a Slack, Discord or Teams sink. ThreadWindow is in seconds.
+type ChatConfig struct {
+	Name         string `yaml:"name"`
+	Format       string `yaml:"format"`
+	URL          string `yaml:"url"`
+	Token        string `yaml:"token"`
+	Channel      string `yaml:"channel"`
+	Threads      bool   `yaml:"threads"`
+	ThreadWindow int    `yaml:"thread_window"`
+	RouteConfig  `yaml:",inline"`
+}
+
+// DesktopConfig represents native desktop notifications. Snooze is in seconds.
+type DesktopConfig struct {
+	Enabled     bool `yaml:"enabled"`
+	RateLimit   int  `yaml:"rate_limit"` // Notifications per minute
+	Snooze      int  `yaml:"snooze"`
+	RouteConfig `yaml:",inline"`
+}
+
+// Default returns the default configuration
+func Default() *Config {
+	th := monitor.DefaultThresholdConfig()
+	return &Config{
+		Monitor: MonitorConfig{
+			Interval:          5,
+			ResourceInterval:  int(monitor.DefaultResourceInterval / time.Second),
+			DiskUsageInterval: int(monitor.DefaultDiskUsageInterval / time.Second),
+			ImpactWindow:      int(monitor.DefaultImpactWindow / time.Second),
+			HistorySize:       monitor.DefaultMetricHistorySize,
+			Thresholds: ThresholdsConfig{
+				CPU: PercentThreshold{
+					Warning:          th.CPU.Warning,
+					Critical:         th.CPU.Critical,
+					ConsecutiveCount: th.CPU.ConsecutiveCount,
+				},
+				Memory: PercentThreshold{
+					Warning:          th.Memory.Warning,
+					Critical:         th.Memory.Critical,
+					ConsecutiveCount: th.Memory.ConsecutiveCount,
+				},
+			},
+		},
+		GRPC: GRPCConfig{
+			SocketMode: "0600",
+			Timeout:    30,
+		},
+		Log: LogConfig{
+			Level: "info",
+		},
+		Event: EventConfig{
+			MaxHistory: monitor.DefaultEventHistorySize,
+			LogCapture: LogCaptureConfig{
+				Lines:      monitor.DefaultLogCaptureLines,
+				EventTypes: defaultLogCaptureEventTypes(),
+			},
+		},
+		Storage: StorageConfig{
+			Enabled: true,
+			Retention: RetentionConfig{
+				Raw:     int(tsdb.DefaultRawRetention / time.Second),
+				Minute:  int(tsdb.Default1mRetention / time.Second),
+				FiveMin: int(tsdb.Default5mRetention / time.Second),
+				Hour:    int(tsdb.Default1hRetention / time.Second),
+			},
+			MaxSizeMB: 512,
+		},
+		Prometheus: PrometheusConfig{
+			Address: "127.0.0.1:9273",
+			Path:    "/metrics",
+		},
+		OTLP: OTLPConfig{
+			Endpoint: "http://127.0.0.1:4318",
+			Interval: 30,
+		},
+		Notify: NotifyConfig{
+			GroupWait:   int(alert.DefaultGroupWait / time.Second),
+			DedupWindow: int(alert.DefaultDedupWindow / time.Second),
+			Desktop: DesktopConfig{
+				RateLimit:   notify.DefaultDesktopRateLimit,
+				Snooze:      int(notify.DefaultSnoozeDuration / time.Second),
+				RouteConfig: RouteConfig{MinSeverity: "critical"},
+			},
+		},
+	}
+}
+
+// defaultLogCaptureEventTypes returns the names of monitor.DefaultLogCaptureEventTypes
+func defaultLogCaptureEventTypes() []string {
+	var names []string
+	for _, t := range monitor.DefaultLogCaptureEventTypes() {
+		names = append(names, string(t))
+	}
+	return names
+}
+
+// Load reads a configuration file on top of the defaults.
+// If path is empty, DefaultConfigPath() is used and a missing file yields the defaults.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	explicit := path != ""
+	if !explicit {
+		path = DefaultConfigPath()
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !explicit && errors.Is(err, os.ErrNotExist) {
+			return cfg, nil
+		}
+		return nil, fmt.Errorf("read config %s: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	cfg.path = path
+	return cfg, nil
+}
+
+// Path returns the file the configuration was loaded from (empty: built-in defaults)
+func (c *Config) Path() string {
+	return c.path
+}
+
+// Validate checks the configuration for values the daemon cannot run with
+func (c *Config) Validate() error {
+	if time.Duration(c.Monitor.Interval)*time.Second < MinInterval {
+		return fmt.Errorf("monitor.interval must be at least %s", MinInterval)
+	}
+	if c.Monitor.ResourceInterval < 0 || c.Monitor.DiskUsageInterval < 0 || c.Monitor.ImpactWindow < 0 {
+		return fmt.Errorf("monitor intervals must not be negative")
+	}
+	for _, p := range append(append([]string{}, c.Monitor.Filters.ContainerNames...), c.Monitor.Filters.ImageNames...) {
+		if _, err := regexp.Compile(p); err != nil {
+			return fmt.Errorf("invalid filter pattern %q: %w", p, err)
+		}
+	}
+	r := c.Storage.Retention
+	if r.Raw < 0 || r.Minute < 0 || r.FiveMin < 0 || r.Hour < 0 || c.Storage.MaxSizeMB < 0 {
+		return fmt.Errorf("storage retention and max_size_mb must not be negative")
+	}
+	if c.Prometheus.Enabled && (c.Prometheus.Address == "" || !strings.HasPrefix(c.Prometheus.Path, "/")) {
+		return fmt.Errorf("prometheus.address must be set and prometheus.path must start with /")
+	}
+	if c.OTLP.Enabled && !strings.HasPrefix(c.OTLP.Endpoint, "http://") && !strings.HasPrefix(c.OTLP.Endpoint, "https://") {
+		return fmt.Errorf("otlp.endpoint must be an http(s) URL")
+	}
+	for i, p := range c.LogWatch.Patterns {
+		if p.MinCount > 1 && p.Window <= 0 {
+			return fmt.Errorf("log_watch.patterns[%d]: min_count > 1 requires a window", i)
+		}
+	}
+	if c.Notify.GroupWait < 0 || c.Notify.DedupWindow < 0 {
+		return fmt.Errorf("notify.group_wait and notify.dedup_window must not be negative")
+	}
+	for i, w := range c.Notify.Webhooks {
+		if !strings.HasPrefix(w.URL, "http://") && !strings.HasPrefix(w.URL, "https://") {
+			return fmt.Errorf("notify.webhooks[%d].url must be an http(s) URL", i)
+		}
+		if err := w.RouteConfig.validate(); err != nil {
+			return fmt.Errorf("notify.webhooks[%d]: %w", i, err)
+		}
+	}
+	for i, ch := range c.Notify.Chats {
+		switch ch.Format {
+		case notify.FormatSlack, notify.FormatDiscord, notify.FormatTeams:
+		default:
+			return fmt.Errorf("notify.chats[%d].format must be slack, discord or teams", i)
+		}
+		if ch.URL == "" && ch.Token == "" {
+			return fmt.Errorf("notify.chats[%d].url must be set", i)
+		}
+		if ch.ThreadWindow < 0 {
+			return fmt.Errorf("notify.chats[%d].thread_window must not be negative", i)
+		}
+		if err := ch.RouteConfig.validate(); err != nil {
+			return fmt.Errorf("notify.chats[%d]: %w", i, err)
+		}
+	}
+	if d := c.Notify.Desktop; d.Enabled {
+		if d.RateLimit < 0 || d.Snooze < 0 {
+			return fmt.Errorf("notify.desktop: rate_limit and snooze must not be negative")
+		}
+		if err := d.RouteConfig.validate(); err != nil {
+			return fmt.Errorf("notify.desktop: %w", err)
+		}
+	}
+	if _, err := c.SocketMode(); err != nil {
+		return err
+	}
+	if t := c.GRPC.TLS; t.CertFile != "" || t.KeyFile != "" || t.ClientCAFile != "" {
+		if t.CertFile == "" || t.KeyFile == "" {
+			return fmt.Errorf("grpc.tls requires cert_file and key_file")
+		}
+		if c.GRPC.Address == "" {
+			return fmt.Errorf("grpc.tls requires grpc.address (the Unix socket does not use TLS)")
+		}
+	}
+	names := make(map[string]bool)
+	for i, t := range c.GRPC.Auth.Tokens {
+		if t.Name == "" || names[t.Name] {
+			return fmt.Errorf("grpc.auth.tokens[%d]: name must be set and unique", i)
+		}
+		names[t.Name] = true
+		if (t.Token == "") == (t.TokenFile == "") {
+			return fmt.Errorf("grpc.auth.tokens[%d]: exactly one of token and token_file must be set", i)
+		}
+		if _, err := grpc.ParseRole(t.Role); err != nil {
+			return fmt.Errorf("grpc.auth.tokens[%d]: %w", i, err)
+		}
+	}
+	if r := c.GRPC.Auth.SocketRole; r != "" {
+		if _, err := grpc.ParseRole(r); err != nil {
+			return fmt.Errorf("grpc.auth.socket_role: %w", err)
+		}
+	}
+	if a := c.GRPC.Address; len(c.GRPC.Auth.Tokens) > 0 && a != "" && !c.GRPC.TLS.Enabled() && !grpc.IsLocalAddress(a) {
+		return fmt.Errorf("grpc.auth.tokens on %s require grpc.tls (tokens would be sent in plaintext)", a)
+	}
+	return nil
+}
+
+// AuthOptions returns the authentication settings of the gRPC server, nil if no tokens are defined
+func (c *Config) AuthOptions() (*grpc.AuthOptions, error) {
+	if len(c.GRPC.Auth.Tokens) == 0 {
+		return nil, nil
+	}
+	opts := &grpc.AuthOptions{SocketRole: grpc.Role(c.GRPC.Auth.SocketRole)}
+	for _, t := range c.GRPC.Auth.Tokens {
+		token, err := t.Value()
+		if err != nil {
+			return nil, err
+		}
+		opts.Tokens = append(opts.Tokens, grpc.Token{Name: t.Name, Token: token, Role: grpc.Role(t.Role)})
+	}
+	return opts, nil
+}
+
+// AuditLogFile returns the configured audit log of admin calls or the default one
+func (c *Config) AuditLogFile() string {
+	if c.GRPC.Auth.AuditLog != "" {
+		return c.GRPC.Auth.AuditLog
+	}
+	return DefaultAuditLogFile()
+}
+
+// EngineConfig converts the configuration to a monitoring engine configuration
+func (c *Config) EngineConfig() monitor.EngineConfig {
+	cfg := monitor.EngineConfig{
+		Interval:             seconds(c.Monitor.Interval),
+		ResourceInterval:     seconds(c.Monitor.ResourceInterval),
+		DiskUsageInterval:    seconds(c.Monitor.DiskUsageInterval),
+		ImpactWindow:         seconds(c.Monitor.ImpactWindow),
+		ContainerNamePattern: joinPatterns(c.Monitor.Filters.ContainerNames),
+		ImageNamePattern:     joinPatterns(c.Monitor.Filters.ImageNames),
+		Thresholds: monitor.ThresholdConfig{
+			CPU: monitor.CPUThresholdConfig{
+				Warning:          c.Monitor.Thresholds.CPU.Warning,
+				Critical:         c.Monitor.Thresholds.CPU.Critical,
+				ConsecutiveCount: c.Monitor.Thresholds.CPU.ConsecutiveCount,
+			},
+			Memory: monitor.MemoryThresholdConfig{
+				Warning:          c.Monitor.Thresholds.Memory.Warning,
+				Critical:         c.Monitor.Thresholds.Memory.Critical,
+				ConsecutiveCount: c.Monitor.Thresholds.Memory.ConsecutiveCount,
+			},
+			Volume: monitor.VolumeThresholdConfig{
+				GrowthBytes:   c.Monitor.Thresholds.Volume.GrowthBytes,
+				GrowthPercent: c.Monitor.Thresholds.Volume.GrowthPercent,
+			},
+		},
+		LogWatch: monitor.LogWatchConfig{
+			ContainerNamePattern: c.LogWatch.ContainerNamePattern,
+		},
+		LogCapture: monitor.LogCaptureConfig{
+			Lines: c.Event.LogCapture.Lines,
+		},
+		EventHistorySize:  c.Event.MaxHistory,
+		MetricHistorySize: c.Monitor.HistorySize,
+	}
+	if c.Storage.Enabled {
+		cfg.MetricStore = tsdb.Config{
+			Dir:          c.MetricsDir(),
+			RawRetention: seconds(c.Storage.Retention.Raw),
+			Retention1m:  seconds(c.Storage.Retention.Minute),
+			Retention5m:  seconds(c.Storage.Retention.FiveMin),
+			Retention1h:  seconds(c.Storage.Retention.Hour),
+			MaxSize:      c.Storage.MaxSizeMB * 1024 * 1024,
+		}
+	}
+	for _, p := range c.LogWatch.Patterns {
+		cfg.LogWatch.Patterns = append(cfg.LogWatch.Patterns, monitor.LogPattern{
+			Name:         p.Name,
+			Regex:        p.Regex,
+			Stream:       p.Stream,
+			Level:        p.Level,
+			MinCount:     p.MinCount,
+			Window:       seconds(p.Window),
+			RateLimit:    seconds(p.RateLimit),
+			ContextLines: p.ContextLines,
+		})
+	}
+	for _, t := range c.Event.LogCapture.EventTypes {
+		cfg.LogCapture.EventTypes = append(cfg.LogCapture.EventTypes, event.EventType(t))
+	}
+	return cfg
+}
+
+// NotifyRoutes creates the notification sinks with their filters. On error, the sinks
+// already created are closed. The desktop sink is skipped with a warning if no desktop
+// notification service is available.
+func (c *Config) NotifyRoutes() (routes []notify.Route, err error) {
+	defer func() {
+		if err != nil {
+			closeSinks(routes)
+			routes = nil
+		}
+	}()
+	for _, w := range c.Notify.Webhooks {
+		sink, err := notify.NewWebhookSink(notify.WebhookOptions{
+			Name:         w.Name,
+			URL:          w.URL,
+			Headers:      w.Headers,
+			Timeout:      seconds(w.Timeout),
+			BodyTemplate: w.BodyTemplate,
+		})
+		if err != nil {
+			return routes, err
+		}
+		route, err := w.RouteConfig.route(sink)
+		if err != nil {
+			return routes, err
+		}
+		routes = append(routes, route)
+	}
+	for _, ch := range c.Notify.Chats {
+		sink, err := notify.NewChatSink(notify.ChatOptions{
+			Name:         ch.Name,
+			Format:       ch.Format,
+			URL:          ch.URL,
+			Timeout:      seconds(ch.Timeout),
+			Token:        ch.Token,
+			Channel:      ch.Channel,
+			Threads:      ch.Threads,
+			ThreadWindow: seconds(ch.ThreadWindow),
+		})
+		if err != nil {
+			return routes, err
+		}
+		route, err := ch.RouteConfig.route(sink)
+		if err != nil {
+			return routes, err
+		}
+		routes = append(routes, route)
+	}
+	if d := c.Notify.Desktop; d.Enabled {
+		backend, err := notify.DefaultDesktopBackend()
+		if err != nil {
+			fmt.Printf("Warning: desktop notifications disabled: %v\n", err)
+			return routes, nil
+		}
+		sink := notify.NewDesktopSink(notify.DesktopOptions{RateLimit: d.RateLimit, Snooze: seconds(d.Snooze)}, backend)
+		route, err := d.RouteConfig.route(sink)
+		if err != nil {
+			sink.Close()
+			return routes, err
+		}
+		// A desktop notification is only useful right away
+		route.Retry.MaxAttempts = 1
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+// closeSinks closes the sinks of routes that implement io.Closer
+func closeSinks(routes []notify.Route) {
+	for _, r := range routes {
+		if c, ok := r.Sink.(io.Closer); ok {
+			c.Close()
+		}
+	}
+}
+
+// validate checks the filter and delivery settings of a sink
+func (r RouteConfig) validate() error {
+	if name := r.minSeverity(); name != "" {
+		if _, err := event.ParseSeverity(name); err != nil {
+			return fmt.Errorf("min_severity: %w", err)
+		}
+	}
+	if r.MaxAttempts < 0 || r.Timeout < 0 {
+		return fmt.Errorf("max_attempts and timeout must not be negative")
+	}
+	for _, p := range r.ContainerNames {
+		if _, err := regexp.Compile(p); err != nil {
+			return fmt.Errorf("invalid container pattern %q: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// minSeverity returns the configured minimum severity, honoring the deprecated min_level key.
+// min_level wins because min_severity may hold a default (e.g. of the desktop sink).
+func (r RouteConfig) minSeverity() string {
+	if r.MinLevel != "" {
+		return r.MinLevel
+	}
+	return r.MinSeverity
+}
+
+// route connects a sink to the events selected by the settings
+func (r RouteConfig) route(sink notify.Sink) (notify.Route, error) {
+	route := notify.Route{
+		Sink:  sink,
+		Retry: notify.RetryPolicy{MaxAttempts: r.MaxAttempts},
+	}
+	if name := r.minSeverity(); name != "" {
+		s, err := event.ParseSeverity(name)
+		if err != nil {
+			return route, fmt.Errorf("min_severity: %w", err)
+		}
+		route.Filter.MinSeverity = s
+	}
+	for _, t := range r.EventTypes {
+		route.Filter.EventTypes = append(route.Filter.EventTypes, event.EventType(t))
+	}
+	if p := joinPatterns(r.ContainerNames); p != "" {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return route, fmt.Errorf("invalid container pattern: %w", err)
+		}
+		route.Filter.Containers = re
+	}
+	return route, nil
+}
+
+// AlertOptions converts the configuration to alert manager options
+func (c *Config) AlertOptions() alert.Options {
+	opts := alert.Options{
+		GroupWait:    seconds(c.Notify.GroupWait),
+		DedupWindow:  seconds(c.Notify.DedupWindow),
+		SilencesFile: c.SilencesFile(),
+	}
+	// 0 disables grouping and deduplication in the configuration file, but selects the defaults in alert.Options
+	if opts.GroupWait == 0 {
+		opts.GroupWait = -1
+	}
+	if opts.DedupWindow == 0 {
+		opts.DedupWindow = -1
+	}
+	return opts
+}
+
+// SilencesFile returns the configured silences file or the default one
+func (c *Config) SilencesFile() string {
+	if c.Notify.SilencesFile != "" {
+		return c.Notify.SilencesFile
+	}
+	return DefaultSilencesFile()
+}
+
+// DeadLetterFile returns the configured notification dead-letter file or the default one
+func (c *Config) DeadLetterFile() string {
+	if c.Notify.DeadLetterFile != "" {
+		return c.Notify.DeadLetterFile
+	}
+	return DefaultDeadLetterFile()
+}
+
+// PIDFile returns the configured PID file path or the default one
+func (c *Config) PIDFile() string {
+	if c.Daemon.PIDFile != "" {
+		return c.Daemon.PIDFile
+	}
+	return DefaultPIDFile()
+}
+
+// SocketPath returns the configured Unix socket path or the default one
+func (c *Config) SocketPath() string {
+	if c.GRPC.Socket != "" {
+		return c.GRPC.Socket
+	}
+	return DefaultSocketPath()
+}
+
+// SocketMode returns the permissions of the Unix socket
+func (c *Config) SocketMode() (os.FileMode, error) {
+	if c.GRPC.SocketMode == "" {
+		return 0o600, nil
+	}
+	mode, err := strconv.ParseUint(c.GRPC.SocketMode, 8, 32)
+	if err != nil || mode > 0o777 {
+		return 0, fmt.Errorf("grpc.socket_mode must be octal permissions like 0660")
+	}
+	return os.FileMode(mode), nil
+}
+
+// MetricsDir returns the configured metric storage directory or the default one
+func (c *Config) MetricsDir() string {
+	if c.Storage.Path != "" {
+		return c.Storage.Path
+	}
+	return DefaultMetricsDir()
+}
+
+// DefaultConfigPath returns the default configuration file path (~/.config/docksphinx/docksphinx.yaml)
+func DefaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "docksphinx.yaml"
+	}
+	return filepath.Join(dir, "docksphinx", "docksphinx.yaml")
+}
+
+// DefaultMetricsDir returns the default metric storage directory ($XDG_DATA_HOME/docksphinx/metrics)
+func DefaultMetricsDir() string {
+	return filepath.Join(dataDir(), "metrics")
+}
+
+// DefaultDeadLetterFile returns the default notification dead-letter file
+// ($XDG_DATA_HOME/docksphinx/notify-dead-letter.jsonl)
+func DefaultDeadLetterFile() string {
+	return filepath.Join(dataDir(), "notify-dead-letter.jsonl")
+}
+
+// DefaultCertsDir returns the directory `docksphinx certs init` writes to (~/.config/docksphinx/certs)
+func DefaultCertsDir() string {
+	return filepath.Join(filepath.Dir(DefaultConfigPath()), "certs")
+}
+
+// DefaultSocketPath returns the default Unix socket of docksphinxd ($XDG_RUNTIME_DIR/docksphinx.sock)
+func DefaultSocketPath() string {
+	return filepath.Join(runtimeDir(), "docksphinx.sock")
+}
+
+// DefaultPIDFile returns the default PID file path for docksphinxd
+func DefaultPIDFile() string {
+	return filepath.Join(runtimeDir(), "docksphinxd.pid")
+}
+
+// DefaultLogFile returns the log file used when docksphinxd runs in the background
+func DefaultLogFile() string {
+	return filepath.Join(runtimeDir(), "docksphinxd.log")
+}
+
+// dataDir returns a per-user directory for persistent data
+func dataDir() string {
+	if dir := os.Getenv("XDG_DATA_HOME"); dir != "" {
+		return filepath.Join(dir, "docksphinx")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return runtimeDir()
+	}
+	return filepath.Join(home, ".local", "share", "docksphinx")
+}
+
+// DefaultAuditLogFile returns the default audit log of admin calls ($XDG_DATA_HOME/docksphinx/audit.jsonl)
+func DefaultAuditLogFile() string {
+	return filepath.Join(dataDir(), "audit.jsonl")
+}
+
+// DefaultSilencesFile returns the default silences file ($XDG_DATA_HOME/docksphinx/silences.json)
+func DefaultSilencesFile() string {
+	return filepath.Join(dataDir(), "silences.json")
+}
+
+// runtimeDir returns a per-user directory for runtime files
+func runtimeDir() string {
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		return dir
+	}
+	return filepath.Join(os.TempDir(), fmt.Sprintf("docksphinx-%d", os.Getuid()))
+}
+
+// joinPatterns combines regex patterns into a single alternation
+func joinPatterns(patterns []string) string {
+	if len(patterns) == 0 {
+		return ""
+	}
+	if len(patterns) == 1 {
+		return patterns[0]
+	}
+	parts := make([]string, len(patterns))
+	for i, p := range patterns {
+		parts[i] = "(?:" + p + ")"
+	}
+	return strings.Join(parts, "|")
+}
+
+func seconds(n int) time.Duration {
+	return time.Duration(n) * time.Second
+}
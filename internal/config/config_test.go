@@ -0,0 +1,129 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"docksphinx/internal/event"
+)
+
+func TestLoadExample(t *testing.T) {
+	cfg, err := Load(filepath.Join("..", "..", "configs", "docksphinx.yaml.example"))
+	if err != nil {
+		t.Fatalf("Failed to load example config: %v", err)
+	}
+
+	ec := cfg.EngineConfig()
+	if ec.Interval != 5*time.Second {
+		t.Errorf("Expected interval 5s, got %s", ec.Interval)
+	}
+	if ec.Thresholds.CPU.Critical != 90 {
+		t.Errorf("Expected CPU critical 90, got %v", ec.Thresholds.CPU.Critical)
+	}
+	if len(ec.LogWatch.Patterns) != 3 || ec.LogWatch.Patterns[2].Window != time.Minute {
+		t.Errorf("Unexpected log patterns: %+v", ec.LogWatch.Patterns)
+	}
+	if cfg.GRPC.Address != "" {
+		t.Errorf("Expected TCP to be disabled, got %s", cfg.GRPC.Address)
+	}
+	if mode, err := cfg.SocketMode(); err != nil || mode != 0o600 || cfg.SocketPath() != DefaultSocketPath() {
+		t.Errorf("Unexpected socket settings: %s %v (%v)", cfg.SocketPath(), mode, err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "partial.yaml")
+	data := "monitor:\n  interval: 10\n  filters:\n    container_names: [\"^api\", \"^db\"]\n"
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	if cfg.Path() != path {
+		t.Errorf("Expected path %s, got %q", path, cfg.Path())
+	}
+	ec := cfg.EngineConfig()
+	if ec.Interval != 10*time.Second {
+		t.Errorf("Expected interval 10s, got %s", ec.Interval)
+	}
+	if ec.ContainerNamePattern != "(?:^api)|(?:^db)" {
+		t.Errorf("Unexpected container pattern: %s", ec.ContainerNamePattern)
+	}
+	if ec.Thresholds.Memory.Warning != 80 {
+		t.Errorf("Expected default memory warning, got %v", ec.Thresholds.Memory.Warning)
+	}
+
+	invalid := filepath.Join(dir, "invalid.yaml")
+	if err := os.WriteFile(invalid, []byte("monitor:\n  interval: 0\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(invalid); err == nil {
+		t.Error("Expected error for interval below minimum")
+	}
+
+	badMode := filepath.Join(dir, "bad-mode.yaml")
+	if err := os.WriteFile(badMode, []byte("grpc:\n  socket_mode: \"rw\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(badMode); err == nil {
+		t.Error("Expected error for non-octal socket mode")
+	}
+
+	badRole := filepath.Join(dir, "bad-role.yaml")
+	if err := os.WriteFile(badRole, []byte("grpc:\n  auth:\n    tokens:\n      - {name: ci, token: x, role: root}\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(badRole); err == nil {
+		t.Error("Expected error for unknown token role")
+	}
+
+	plaintext := filepath.Join(dir, "plaintext-tokens.yaml")
+	data = "grpc:\n  address: \"0.0.0.0:50051\"\n  auth:\n    tokens:\n      - {name: ci, token: x, role: admin}\n"
+	if err := os.WriteFile(plaintext, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(plaintext); err == nil {
+		t.Error("Expected error for tokens on a remote address without TLS")
+	}
+
+	noWindow := filepath.Join(dir, "no-window.yaml")
+	data = "log_watch:\n  patterns:\n    - {name: errors, regex: error, min_count: 3}\n"
+	if err := os.WriteFile(noWindow, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(noWindow); err == nil {
+		t.Error("Expected error for min_count without window")
+	}
+
+	legacy := filepath.Join(dir, "legacy.yaml")
+	data = "notify:\n  webhooks:\n    - {name: ops, url: \"http://127.0.0.1:9/hook\", min_level: warning}\n"
+	if err := os.WriteFile(legacy, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = Load(legacy)
+	if err != nil {
+		t.Fatalf("Failed to load config with min_level: %v", err)
+	}
+	routes, err := cfg.NotifyRoutes()
+	if err != nil || len(routes) != 1 || routes[0].Filter.MinSeverity != event.SeverityWarning {
+		t.Errorf("Expected min_level to set the minimum severity, got %+v (%v)", routes, err)
+	}
+
+	// Without a session bus the desktop sink is skipped instead of failing startup
+	t.Setenv("DBUS_SESSION_BUS_ADDRESS", "unix:path="+filepath.Join(dir, "no-bus"))
+	cfg.Notify.Desktop.Enabled = true
+	routes, err = cfg.NotifyRoutes()
+	if err != nil || len(routes) != 1 {
+		t.Errorf("Expected only the webhook route without a desktop, got %+v (%v)", routes, err)
+	}
+
+	if _, err := Load(filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Error("Expected error for missing explicit config file")
+	}
+}
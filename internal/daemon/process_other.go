@@ -0,0 +1,37 @@
+//go:build !unix
+
+package daemon
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// errUnsupported is returned by the process management functions on this platform
+var errUnsupported = fmt.Errorf("managing docksphinxd as a background process is not supported on %s", runtime.GOOS)
+
+// Supported reports whether docksphinxd can be managed as a background process on this platform
+func Supported() error {
+	return errUnsupported
+}
+
+// ProcessAlive always returns false on this platform
+func ProcessAlive(pid int) bool {
+	return false
+}
+
+// Detach is not supported on this platform
+func Detach(cmd *exec.Cmd) error {
+	return errUnsupported
+}
+
+// Terminate is not supported on this platform
+func Terminate(pid int) error {
+	return errUnsupported
+}
+
+// Kill is not supported on this platform
+func Kill(pid int) error {
+	return errUnsupported
+}
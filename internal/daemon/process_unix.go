@@ -0,0 +1,37 @@
+//go:build unix
+
+package daemon
+
+import (
+	"errors"
+	"os/exec"
+	"syscall"
+)
+
+// Supported reports whether docksphinxd can be managed as a background process on this platform
+func Supported() error {
+	return nil
+}
+
+// ProcessAlive reports whether a process with the given PID exists
+func ProcessAlive(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	// EPERM: the process exists but belongs to another user
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
+
+// Detach starts cmd in a new session so that it survives the terminal
+func Detach(cmd *exec.Cmd) error {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	return nil
+}
+
+// Terminate asks the process to shut down (SIGTERM)
+func Terminate(pid int) error {
+	return syscall.Kill(pid, syscall.SIGTERM)
+}
+
+// Kill stops the process immediately (SIGKILL)
+func Kill(pid int) error {
+	return syscall.Kill(pid, syscall.SIGKILL)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +20,7 @@ type Container struct {
 	Status  string
 	State   string
 	Created int64
+	Labels  map[string]string
 }
 
 // ListContainersOptions specifies options for listing containers
@@ -65,6 +67,7 @@ func (c *Client) ListContainers(ctx context.Context, opts ListContainersOptions)
 			Status:  container.Status,
 			State:   container.State,
 			Created: container.Created,
+			Labels:  container.Labels,
 		}
 
 		// Apply name pattern filter if specified
@@ -221,3 +224,19 @@ func parseCreatedTime(createdStr string) int64 {
 	}
 	return t.Unix()
 }
+
+// exitCodePattern matches the exit code in the status of an exited container, e.g. "Exited (137) 5 seconds ago"
+var exitCodePattern = regexp.MustCompile(`^Exited \((-?\d+)\)`)
+
+// ExitCode returns the exit code in a container status string
+func ExitCode(status string) (int, bool) {
+	m := exitCodePattern.FindStringSubmatch(status)
+	if m == nil {
+		return 0, false
+	}
+	code, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, false
+	}
+	return code, true
+}
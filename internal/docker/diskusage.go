@@ -0,0 +1,96 @@
+package docker
+
+import (
+	"context"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+)
+
+// DiskUsage represents disk usage reported by the Docker system df API
+type DiskUsage struct {
+	LayersSize     int64 // Total size of all image layers
+	ContainersSize int64 // Total size of container writable layers
+	VolumesSize    int64 // Total size of local volumes
+	BuildCacheSize int64 // Total size of the build cache
+	Containers     []ContainerDiskUsage
+	Volumes        []VolumeDiskUsage
+}
+
+// ContainerDiskUsage represents disk usage of a single container
+type ContainerDiskUsage struct {
+	ID         string
+	Name       string
+	SizeRw     int64 // Size of the writable layer
+	SizeRootFs int64 // Size of all files in the container including image layers
+}
+
+// VolumeDiskUsage represents disk usage of a single volume
+type VolumeDiskUsage struct {
+	Name     string
+	Driver   string
+	Size     int64 // -1 if the driver cannot report the size
+	RefCount int64 // Number of containers referencing the volume, -1 if unknown
+}
+
+// DiskUsage retrieves disk usage from the Docker daemon (equivalent to `docker system df -v`)
+// This call walks container and volume filesystems on the daemon side and is expensive,
+// so it should be called much less frequently than ListContainers
+func (c *Client) DiskUsage(ctx context.Context) (*DiskUsage, error) {
+	du, err := c.apiClient.DiskUsage(ctx, types.DiskUsageOptions{})
+	if err != nil {
+		return nil, HandleAPIError(err)
+	}
+
+	result := &DiskUsage{
+		LayersSize: du.LayersSize,
+		Containers: make([]ContainerDiskUsage, 0, len(du.Containers)),
+		Volumes:    make([]VolumeDiskUsage, 0, len(du.Volumes)),
+	}
+
+	for _, ctr := range du.Containers {
+		if ctr == nil {
+			continue
+		}
+		name := ""
+		if len(ctr.Names) > 0 {
+			name = strings.TrimPrefix(ctr.Names[0], "/")
+		}
+		result.ContainersSize += ctr.SizeRw
+		result.Containers = append(result.Containers, ContainerDiskUsage{
+			ID:         ctr.ID,
+			Name:       name,
+			SizeRw:     ctr.SizeRw,
+			SizeRootFs: ctr.SizeRootFs,
+		})
+	}
+
+	for _, vol := range du.Volumes {
+		if vol == nil {
+			continue
+		}
+		usage := VolumeDiskUsage{
+			Name:     vol.Name,
+			Driver:   vol.Driver,
+			Size:     -1,
+			RefCount: -1,
+		}
+		if vol.UsageData != nil {
+			usage.Size = vol.UsageData.Size
+			usage.RefCount = vol.UsageData.RefCount
+		}
+		if usage.Size > 0 {
+			result.VolumesSize += usage.Size
+		}
+		result.Volumes = append(result.Volumes, usage)
+	}
+
+	for _, record := range du.BuildCache {
+		if record == nil {
+			continue
+		}
+		result.BuildCacheSize += record.Size
+	}
+
+	return result, nil
+}
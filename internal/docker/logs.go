@@ -0,0 +1,215 @@
+package docker
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+// Log stream names
+const (
+	LogStreamStdout = "stdout"
+	LogStreamStderr = "stderr"
+)
+
+// LogLine represents a single line of container output
+type LogLine struct {
+	Stream    string    // "stdout" or "stderr" (always "stdout" for TTY containers)
+	Timestamp time.Time // Time the line was written, as recorded by Docker
+	Text      string    // Line content without the trailing newline
+}
+
+// LogsOptions specifies options for retrieving container logs
+type LogsOptions struct {
+	// Tail is the number of lines to return from the end of the logs (0 means all lines)
+	Tail int
+	// Since and Until restrict the time range (zero means unbounded)
+	Since time.Time
+	Until time.Time
+	// Stdout and Stderr select the streams; if both are false, both streams are returned
+	Stdout bool
+	Stderr bool
+}
+
+// GetContainerLogs retrieves container logs
+// Lines from stdout and stderr are returned in the order Docker wrote them
+func (c *Client) GetContainerLogs(ctx context.Context, containerID string, opts LogsOptions) ([]LogLine, error) {
+	var lines []LogLine
+	err := c.readContainerLogs(ctx, containerID, opts, false, func(line LogLine) error {
+		lines = append(lines, line)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// FollowContainerLogs streams container logs, calling fn for each line until ctx is canceled,
+// the container stops or fn returns an error
+// opts.Tail lines of existing output are sent first (0 means all existing lines)
+func (c *Client) FollowContainerLogs(ctx context.Context, containerID string, opts LogsOptions, fn func(LogLine) error) error {
+	return c.readContainerLogs(ctx, containerID, opts, true, fn)
+}
+
+// readContainerLogs opens the log stream and demultiplexes it
+func (c *Client) readContainerLogs(ctx context.Context, containerID string, opts LogsOptions, follow bool, fn func(LogLine) error) error {
+	// TTY containers write a raw stream; others use the multiplexed stream framing
+	inspect, err := c.GetContainer(ctx, containerID)
+	if err != nil {
+		return err
+	}
+	tty := inspect.Config != nil && inspect.Config.Tty
+
+	stdout, stderr := opts.Stdout, opts.Stderr
+	if !stdout && !stderr {
+		stdout, stderr = true, true
+	}
+
+	apiOpts := container.LogsOptions{
+		ShowStdout: stdout,
+		ShowStderr: stderr,
+		Timestamps: true, // Parsed and stripped by parseLogLine
+		Follow:     follow,
+		Tail:       "all",
+	}
+	if opts.Tail > 0 {
+		apiOpts.Tail = strconv.Itoa(opts.Tail)
+	}
+	if !opts.Since.IsZero() {
+		apiOpts.Since = strconv.FormatInt(opts.Since.Unix(), 10)
+	}
+	if !opts.Until.IsZero() {
+		apiOpts.Until = strconv.FormatInt(opts.Until.Unix(), 10)
+	}
+
+	rc, err := c.apiClient.ContainerLogs(ctx, inspect.ID, apiOpts)
+	if err != nil {
+		return HandleAPIError(err)
+	}
+	defer rc.Close()
+
+	err = ReadLogStream(rc, tty, fn)
+	if err != nil && ctx.Err() != nil {
+		// Stream was closed because the caller is done
+		return nil
+	}
+	return err
+}
+
+// Stream types in the multiplexed log framing
+const (
+	streamStdin     = 0
+	streamStdout    = 1
+	streamStderr    = 2
+	streamSystemErr = 3
+)
+
+// ReadLogStream parses a Docker log stream (with timestamps) and calls fn for each line
+// For non-TTY containers the stream is multiplexed: each frame has an 8-byte header
+// [stream, 0, 0, 0, size (big endian uint32)] followed by size bytes of payload.
+// Frames are not aligned to lines, so partial lines are buffered per stream.
+func ReadLogStream(r io.Reader, tty bool, fn func(LogLine) error) error {
+	if tty {
+		return readLines(r, LogStreamStdout, fn)
+	}
+
+	br := bufio.NewReader(r)
+	header := make([]byte, 8)
+	partial := map[string]*strings.Builder{
+		LogStreamStdout: {},
+		LogStreamStderr: {},
+	}
+
+	flush := func() error {
+		for _, stream := range []string{LogStreamStdout, LogStreamStderr} {
+			if b := partial[stream]; b.Len() > 0 {
+				text := b.String()
+				b.Reset()
+				if err := fn(parseLogLine(stream, text)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	for {
+		if _, err := io.ReadFull(br, header); err != nil {
+			if errors.Is(err, io.EOF) {
+				return flush()
+			}
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				return fmt.Errorf("truncated log frame header")
+			}
+			return err
+		}
+
+		size := binary.BigEndian.Uint32(header[4:8])
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(br, payload); err != nil {
+			return fmt.Errorf("truncated log frame: %w", err)
+		}
+
+		var stream string
+		switch header[0] {
+		case streamStdin, streamStdout:
+			stream = LogStreamStdout
+		case streamStderr:
+			stream = LogStreamStderr
+		case streamSystemErr:
+			return fmt.Errorf("docker log stream error: %s", strings.TrimSpace(string(payload)))
+		default:
+			return fmt.Errorf("unknown log stream type %d", header[0])
+		}
+
+		buf := partial[stream]
+		buf.Write(payload)
+		data := buf.String()
+		for {
+			i := strings.IndexByte(data, '\n')
+			if i < 0 {
+				break
+			}
+			if err := fn(parseLogLine(stream, data[:i])); err != nil {
+				return err
+			}
+			data = data[i+1:]
+		}
+		buf.Reset()
+		buf.WriteString(data)
+	}
+}
+
+// readLines reads a raw (TTY) log stream line by line
+func readLines(r io.Reader, stream string, fn func(LogLine) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if err := fn(parseLogLine(stream, scanner.Text())); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// parseLogLine splits the RFC3339Nano timestamp prefix added by Docker from the line
+func parseLogLine(stream, raw string) LogLine {
+	raw = strings.TrimSuffix(raw, "\r")
+	line := LogLine{Stream: stream, Text: raw}
+	if ts, rest, ok := strings.Cut(raw, " "); ok {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			line.Timestamp = t
+			line.Text = rest
+		}
+	}
+	return line
+}
@@ -0,0 +1,79 @@
+package docker
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func frame(stream byte, payload string) []byte {
+	header := make([]byte, 8)
+	header[0] = stream
+	binary.BigEndian.PutUint32(header[4:], uint32(len(payload)))
+	return append(header, payload...)
+}
+
+func TestReadLogStreamMultiplexed(t *testing.T) {
+	var buf bytes.Buffer
+	buf.Write(frame(1, "2024-01-02T03:04:05.000000001Z hello\n2024-01-02T03:04:06Z wor"))
+	buf.Write(frame(2, "2024-01-02T03:04:07Z boom\n"))
+	buf.Write(frame(1, "ld\n"))
+	buf.Write(frame(2, "2024-01-02T03:04:08Z no newline"))
+
+	var lines []LogLine
+	err := ReadLogStream(&buf, false, func(l LogLine) error {
+		lines = append(lines, l)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []struct{ stream, text string }{
+		{"stdout", "hello"},
+		{"stderr", "boom"},
+		{"stdout", "world"},
+		{"stderr", "no newline"},
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("Expected %d lines, got %d: %+v", len(want), len(lines), lines)
+	}
+	for i, w := range want {
+		if lines[i].Stream != w.stream || lines[i].Text != w.text {
+			t.Errorf("Line %d: expected %s %q, got %s %q", i, w.stream, w.text, lines[i].Stream, lines[i].Text)
+		}
+	}
+	if lines[0].Timestamp.Nanosecond() != 1 {
+		t.Errorf("Expected timestamp to be parsed, got %v", lines[0].Timestamp)
+	}
+}
+
+func TestReadLogStreamTTY(t *testing.T) {
+	r := strings.NewReader("2024-01-02T03:04:05Z one\r\n2024-01-02T03:04:06Z two\n")
+
+	var lines []LogLine
+	if err := ReadLogStream(r, true, func(l LogLine) error {
+		lines = append(lines, l)
+		return nil
+	}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(lines) != 2 || lines[0].Text != "one" || lines[1].Text != "two" || lines[1].Stream != "stdout" {
+		t.Errorf("Unexpected lines: %+v", lines)
+	}
+}
+
+func TestReadLogStreamErrors(t *testing.T) {
+	truncated := frame(1, "hello\n")[:10]
+	if err := ReadLogStream(bytes.NewReader(truncated), false, func(LogLine) error { return nil }); err == nil {
+		t.Error("Expected error for truncated frame")
+	}
+
+	stop := errors.New("stop")
+	err := ReadLogStream(bytes.NewReader(frame(1, "a\nb\n")), false, func(LogLine) error { return stop })
+	if !errors.Is(err, stop) {
+		t.Errorf("Expected callback error to be returned, got %v", err)
+	}
+}
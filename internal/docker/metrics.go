@@ -145,7 +145,7 @@ type VolumeMount struct {
 // GetVolumeUsage retrieves volume usage information for a container
 // Note: This is a placeholder for MCP. Actual volume usage calculation
 // requires access to the host filesystem, which is complex on macOS with Docker Desktop
-// For MVP, we return the list of mounted volumes
+// For MVP, we return the list of mounted volumes; use DiskUsage for volume sizes
 func (c *Client) GetVolumeUsage(ctx context.Context, containerID string) ([]VolumeMount, error) {
 	container, err := c.GetContainer(ctx, containerID)
 	if err != nil {
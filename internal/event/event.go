@@ -10,14 +10,27 @@ type EventType string
 
 const (
 	// Container lifecycle events
-	EventTypeStarted   EventType = "started"   // container started
-	EventTypeStopped   EventType = "stopped"   // container stopped
-	EventTypeRestarted EventType = "restarted" // Container restarted
-	EventTypeDied      EventType = "died"      // Container died (abnormal exit)
+	EventTypeStarted   EventType = "started"    // container started
+	EventTypeStopped   EventType = "stopped"    // container stopped
+	EventTypeRestarted EventType = "restarted"  // Container restarted
+	EventTypeDied      EventType = "died"       // Container died (abnormal exit)
+	EventTypeOOMKilled EventType = "oom_killed" // Container was killed by the kernel OOM killer
+	EventTypeCrashLoop EventType = "crash_loop" // Container crashed repeatedly within a short window
+
+	// Dependency events
+	EventTypeImpact EventType = "impact" // Failure of a container was followed by failures of its dependents
 
 	// Resource threshold events
 	EventTypeCPUThreshold EventType = "cpu_threshold" // CPU usage exceeded threshold
 	EventTypeMemThreshold EventType = "mem_threshold" // Memory usage exceeded threshold
+	EventTypeVolumeGrowth EventType = "volume_growth" // Volume size grew faster than threshold
+
+	// Log events
+	EventTypeLogMatch EventType = "log_match" // Container log line matched a configured pattern
+
+	// Docker daemon connection events
+	EventTypeDockerDisconnected EventType = "docker_disconnected" // Docker daemon became unreachable
+	EventTypeDockerReconnected  EventType = "docker_reconnected"  // Docker daemon is reachable again
 )
 
 // Event represents a monitoring event
@@ -32,12 +45,32 @@ type Event struct {
 	ContainerName string // Container name
 	ImageName     string // Image name
 
+	// Container labels (e.g. compose project and service), attached when the event is published
+	Labels map[string]string
+
 	// Event-specific data
 	// For threshold events, this contains the threshold value and actual value
 	Data map[string]interface{}
 
+	// Typed event-specific data (nil for events without details); see SetPayload
+	Payload Payload
+
 	// Message for human-readable description
 	Message string
+
+	// Severity assigned by the detector that generated the event
+	Severity Severity
+
+	// Last log lines of the container, captured when the event was generated
+	// (only for event types configured for log capture, e.g. died)
+	Logs []LogLine
+}
+
+// LogLine represents a container log line captured with an event
+type LogLine struct {
+	Stream    string // "stdout" or "stderr"
+	Timestamp time.Time
+	Text      string
 }
 
 // NewEvent creates a new event
@@ -60,3 +93,31 @@ func generateEventID() string {
 	return time.Now().Format("20060102150405") + "-" +
 		fmt.Sprintf("%d", time.Now().UnixNano()%1000000)
 }
+
+// Severity represents the severity of an event. Values are ordered, so they can be compared.
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityCritical
+)
+
+var severityNames = [...]string{"info", "warning", "critical"}
+
+func (s Severity) String() string {
+	if s < 0 || int(s) >= len(severityNames) {
+		return fmt.Sprintf("severity(%d)", int(s))
+	}
+	return severityNames[s]
+}
+
+// ParseSeverity returns the severity with the given name (info, warning or critical)
+func ParseSeverity(name string) (Severity, error) {
+	for i, n := range severityNames {
+		if n == name {
+			return Severity(i), nil
+		}
+	}
+	return SeverityInfo, fmt.Errorf("unknown severity %q (want info, warning or critical)", name)
+}
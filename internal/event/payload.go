@@ -0,0 +1,208 @@
+package event
+
+import (
+	"strings"
+	"time"
+)
+
+// Payload is the typed, event type specific detail of an event.
+// Its fields are also written to Event.Data under the keys used before payloads existed.
+type Payload interface {
+	// Fields returns the payload as Data entries
+	Fields() map[string]interface{}
+}
+
+// SetPayload attaches p to the event and copies its fields into Data.
+// Data["level"] is set to the severity for clients that predate Severity, so set it first.
+// Call again after modifying the payload to update Data.
+func (e *Event) SetPayload(p Payload) {
+	e.Payload = p
+	if e.Data == nil {
+		e.Data = make(map[string]interface{})
+	}
+	for k, v := range p.Fields() {
+		e.Data[k] = v
+	}
+	e.Data["level"] = e.Severity.String()
+}
+
+// StateChangePayload is the payload of started, stopped, restarted, died and oom_killed events
+type StateChangePayload struct {
+	PreviousState string        // Empty for a container seen for the first time
+	TimeSinceStop time.Duration // Only for restarted events
+	ExitCode      int           // Only for stopped, died and oom_killed events
+	// Containers depending on the container (only for stopped and died events)
+	Dependents   []string
+	DependentIDs []string
+}
+
+// Fields implements Payload
+func (p *StateChangePayload) Fields() map[string]interface{} {
+	f := map[string]interface{}{}
+	if p.PreviousState != "" {
+		f["previous_state"] = p.PreviousState
+	}
+	if p.TimeSinceStop > 0 {
+		f["time_since_stop"] = p.TimeSinceStop.Seconds()
+	}
+	if p.ExitCode != 0 {
+		f["exit_code"] = p.ExitCode
+	}
+	if len(p.DependentIDs) > 0 {
+		f["dependents"] = strings.Join(p.Dependents, ",")
+		f["dependent_ids"] = strings.Join(p.DependentIDs, ",")
+		f["dependent_count"] = len(p.DependentIDs)
+	}
+	return f
+}
+
+// CrashLoopPayload is the payload of crash_loop events
+type CrashLoopPayload struct {
+	Crashes  int           // Crashes within Window
+	Window   time.Duration // Detection window
+	ExitCode int           // Exit code of the last crash (0 if unknown)
+}
+
+// Fields implements Payload
+func (p *CrashLoopPayload) Fields() map[string]interface{} {
+	f := map[string]interface{}{
+		"crashes": p.Crashes,
+		"window":  p.Window.Seconds(),
+	}
+	if p.ExitCode != 0 {
+		f["exit_code"] = p.ExitCode
+	}
+	return f
+}
+
+// Threshold metrics
+const (
+	MetricCPU    = "cpu"
+	MetricMemory = "memory"
+)
+
+// ThresholdPayload is the payload of cpu_threshold and mem_threshold events
+type ThresholdPayload struct {
+	Metric           string  // MetricCPU or MetricMemory
+	Value            float64 // Usage in percent
+	Threshold        float64 // Exceeded threshold in percent
+	ConsecutiveCount int     // Number of consecutive collections above the threshold
+}
+
+// Fields implements Payload
+func (p *ThresholdPayload) Fields() map[string]interface{} {
+	return map[string]interface{}{
+		p.Metric + "_percent": p.Value,
+		"threshold":           p.Threshold,
+		"consecutive_count":   p.ConsecutiveCount,
+	}
+}
+
+// VolumeGrowthPayload is the payload of volume_growth events
+type VolumeGrowthPayload struct {
+	VolumeName    string
+	Size          int64 // Bytes
+	PreviousSize  int64 // Bytes
+	GrowthBytes   int64
+	GrowthPercent float64
+	RefCount      int64 // -1 if unknown
+}
+
+// Fields implements Payload
+func (p *VolumeGrowthPayload) Fields() map[string]interface{} {
+	return map[string]interface{}{
+		"volume_name":    p.VolumeName,
+		"size":           p.Size,
+		"previous_size":  p.PreviousSize,
+		"growth_bytes":   p.GrowthBytes,
+		"growth_percent": p.GrowthPercent,
+		"ref_count":      p.RefCount,
+	}
+}
+
+// LogMatchPayload is the payload of log_match events
+type LogMatchPayload struct {
+	Pattern    string
+	Line       string
+	Stream     string
+	MatchCount int           // Matches within Window (1 without rate detection)
+	Window     time.Duration // Zero without rate detection
+	Suppressed int           // Matches suppressed by rate limiting since the previous event
+	Context    []string      // Lines around the match, including the matching line
+}
+
+// Fields implements Payload
+func (p *LogMatchPayload) Fields() map[string]interface{} {
+	f := map[string]interface{}{
+		"pattern":     p.Pattern,
+		"line":        p.Line,
+		"stream":      p.Stream,
+		"match_count": p.MatchCount,
+		"suppressed":  p.Suppressed,
+	}
+	if p.Window > 0 {
+		f["window_seconds"] = p.Window.Seconds()
+	}
+	if len(p.Context) > 1 {
+		f["context"] = strings.Join(p.Context, "\n")
+	}
+	return f
+}
+
+// ImpactPayload is the payload of impact events
+type ImpactPayload struct {
+	RootCauseFailedAt time.Time
+	Affected          []string // Names of the affected dependents
+	AffectedIDs       []string
+	Window            time.Duration
+}
+
+// Fields implements Payload
+func (p *ImpactPayload) Fields() map[string]interface{} {
+	return map[string]interface{}{
+		"root_cause_failed_at": p.RootCauseFailedAt.Unix(),
+		"affected":             strings.Join(p.Affected, ","),
+		"affected_ids":         strings.Join(p.AffectedIDs, ","),
+		"affected_count":       len(p.Affected),
+		"window_seconds":       p.Window.Seconds(),
+	}
+}
+
+// GroupPayload is the payload of an alert summarizing the events of a group
+type GroupPayload struct {
+	Group      string   // Compose project or container name
+	Count      int      // Number of grouped events
+	Containers []string // Sorted container names
+}
+
+// Fields implements Payload
+func (p *GroupPayload) Fields() map[string]interface{} {
+	return map[string]interface{}{
+		"group":         p.Group,
+		"grouped_count": p.Count,
+		"containers":    strings.Join(p.Containers, ","),
+	}
+}
+
+// DockerConnectionPayload is the payload of docker_disconnected and docker_reconnected events
+type DockerConnectionPayload struct {
+	Error      string        // Why the daemon is unreachable (only for docker_disconnected)
+	Downtime   time.Duration // Time the daemon was unreachable (only for docker_reconnected)
+	Attempts   int           // Failed collections before reconnecting (only for docker_reconnected)
+	Containers int           // Containers marked unknown, or resynced on reconnect
+}
+
+// Fields implements Payload
+func (p *DockerConnectionPayload) Fields() map[string]interface{} {
+	f := map[string]interface{}{
+		"container_count": p.Containers,
+	}
+	if p.Error != "" {
+		f["error"] = p.Error
+	}
+	if p.Downtime > 0 {
+		f["downtime"] = p.Downtime.Seconds()
+		f["attempts"] = p.Attempts
+	}
+	return f
+}
@@ -0,0 +1,377 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"docksphinx/internal/event"
+	"docksphinx/internal/graph"
+	"docksphinx/internal/monitor"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultOTLPInterval is the default interval of metric exports
+	DefaultOTLPInterval = 30 * time.Second
+	// otlpLogBatchInterval bounds how long events wait before being exported
+	otlpLogBatchInterval = 5 * time.Second
+	// otlpLogBatchSize exports events early once this many are pending
+	otlpLogBatchSize = 100
+	// otlpMaxPendingLogs drops the oldest events while the collector is unreachable
+	otlpMaxPendingLogs = 10000
+
+	scopeName = "docksphinx"
+)
+
+// EventSource provides event subscriptions (implemented by the gRPC server)
+type EventSource interface {
+	Subscribe() (<-chan *event.Event, func())
+}
+
+// OTLPOptions configures the OTLP exporter
+type OTLPOptions struct {
+	Endpoint string            // Collector base URL, e.g. http://127.0.0.1:4318 (OTLP/HTTP, protobuf)
+	Headers  map[string]string // Extra request headers, e.g. for authentication
+	Interval time.Duration     // Metric export interval (default: DefaultOTLPInterval)
+	Timeout  time.Duration     // Request timeout (default: 10s)
+	Hostname string            // host.name resource attribute (default: os.Hostname)
+}
+
+// OTLPExporter pushes container metrics as OTLP metrics and events as OTLP log records.
+// Data is grouped into one resource per compose project.
+type OTLPExporter struct {
+	opts   OTLPOptions
+	engine *monitor.Engine
+	events EventSource
+	client *http.Client
+
+	pending []*event.Event
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewOTLPExporter creates an OTLP exporter. events may be nil to export metrics only.
+func NewOTLPExporter(opts OTLPOptions, engine *monitor.Engine, events EventSource) (*OTLPExporter, error) {
+	if !strings.HasPrefix(opts.Endpoint, "http://") && !strings.HasPrefix(opts.Endpoint, "https://") {
+		return nil, fmt.Errorf("otlp endpoint must be an http(s) URL: %q", opts.Endpoint)
+	}
+	opts.Endpoint = strings.TrimSuffix(opts.Endpoint, "/")
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultOTLPInterval
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	if opts.Hostname == "" {
+		opts.Hostname, _ = os.Hostname()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &OTLPExporter{
+		opts:   opts,
+		engine: engine,
+		events: events,
+		client: &http.Client{Timeout: opts.Timeout},
+		ctx:    ctx,
+		cancel: cancel,
+	}, nil
+}
+
+// Start starts exporting in the background
+func (x *OTLPExporter) Start() {
+	var events <-chan *event.Event
+	unsub := func() {}
+	if x.events != nil {
+		events, unsub = x.events.Subscribe()
+	}
+
+	x.wg.Add(1)
+	go func() {
+		defer x.wg.Done()
+		defer unsub()
+		x.run(events)
+	}()
+}
+
+// Stop stops exporting after a final export of pending events
+func (x *OTLPExporter) Stop() {
+	x.cancel()
+	x.wg.Wait()
+}
+
+// run exports metrics every Interval and batches events
+func (x *OTLPExporter) run(events <-chan *event.Event) {
+	metricTicker := time.NewTicker(x.opts.Interval)
+	defer metricTicker.Stop()
+	logTicker := time.NewTicker(otlpLogBatchInterval)
+	defer logTicker.Stop()
+
+	for {
+		select {
+		case <-x.ctx.Done():
+			x.flushEvents(context.Background())
+			return
+		case <-metricTicker.C:
+			if err := x.ExportMetrics(x.ctx); err != nil {
+				fmt.Printf("Error exporting OTLP metrics: %v\n", err)
+			}
+		case <-logTicker.C:
+			x.flushEvents(x.ctx)
+		case evt, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			x.pending = append(x.pending, evt)
+			if len(x.pending) > otlpMaxPendingLogs {
+				x.pending = x.pending[len(x.pending)-otlpMaxPendingLogs:]
+			}
+			if len(x.pending) >= otlpLogBatchSize {
+				x.flushEvents(x.ctx)
+			}
+		}
+	}
+}
+
+// flushEvents exports pending events, keeping them for the next attempt on failure
+func (x *OTLPExporter) flushEvents(ctx context.Context) {
+	if len(x.pending) == 0 {
+		return
+	}
+	if err := x.ExportEvents(ctx, x.pending); err != nil {
+		fmt.Printf("Error exporting OTLP logs: %v\n", err)
+		return
+	}
+	x.pending = nil
+}
+
+// ExportMetrics exports the current metrics of all running containers
+func (x *OTLPExporter) ExportMetrics(ctx context.Context) error {
+	sm := x.engine.GetStateManager()
+	depGraph, _ := sm.GetDependencyGraph()
+	now := uint64(time.Now().UnixNano())
+
+	byProject := make(map[string][]*metricspb.Metric)
+	for id, st := range sm.GetAllStates() {
+		if st.State != "running" {
+			continue
+		}
+		project, service := composeLabels(depGraph, id)
+		attrs := containerAttributes(st.ContainerName, id, st.ImageName, service)
+		byProject[project] = append(byProject[project],
+			gauge("container.cpu.percent", "%", "CPU usage in percent of one CPU", now, st.CPUPercent, attrs),
+			gauge("container.memory.usage", "By", "Memory usage", now, float64(st.MemoryUsage), attrs),
+			gauge("container.memory.limit", "By", "Memory limit", now, float64(st.MemoryLimit), attrs),
+			gauge("container.memory.percent", "%", "Memory usage in percent of the limit", now, st.MemoryPercent, attrs),
+			counter("container.network.io", "By", "Network bytes",
+				directionPoint(now, float64(st.NetworkRx), "receive", attrs),
+				directionPoint(now, float64(st.NetworkTx), "transmit", attrs)),
+			counter("container.disk.io", "By", "Block device bytes",
+				directionPoint(now, float64(st.BlockRead), "read", attrs),
+				directionPoint(now, float64(st.BlockWrite), "write", attrs)),
+		)
+	}
+	if len(byProject) == 0 {
+		return nil
+	}
+
+	req := &colmetricspb.ExportMetricsServiceRequest{}
+	for _, project := range sortedKeys(byProject) {
+		req.ResourceMetrics = append(req.ResourceMetrics, &metricspb.ResourceMetrics{
+			Resource: x.resource(project),
+			ScopeMetrics: []*metricspb.ScopeMetrics{{
+				Scope:   &commonpb.InstrumentationScope{Name: scopeName},
+				Metrics: byProject[project],
+			}},
+		})
+	}
+	return x.post(ctx, "/v1/metrics", req)
+}
+
+// ExportEvents exports events as log records
+func (x *OTLPExporter) ExportEvents(ctx context.Context, events []*event.Event) error {
+	depGraph, _ := x.engine.GetStateManager().GetDependencyGraph()
+
+	byProject := make(map[string][]*logspb.LogRecord)
+	for _, evt := range events {
+		project, service := composeLabels(depGraph, evt.ContainerID)
+		byProject[project] = append(byProject[project], logRecord(evt, service))
+	}
+
+	req := &collogspb.ExportLogsServiceRequest{}
+	for _, project := range sortedKeys(byProject) {
+		req.ResourceLogs = append(req.ResourceLogs, &logspb.ResourceLogs{
+			Resource: x.resource(project),
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &commonpb.InstrumentationScope{Name: scopeName},
+				LogRecords: byProject[project],
+			}},
+		})
+	}
+	return x.post(ctx, "/v1/logs", req)
+}
+
+// resource returns the resource of the host and a compose project (empty for standalone containers)
+func (x *OTLPExporter) resource(project string) *resourcepb.Resource {
+	attrs := []*commonpb.KeyValue{
+		stringAttr("service.name", "docksphinx"),
+		stringAttr("host.name", x.opts.Hostname),
+	}
+	if project != "" {
+		attrs = append(attrs, stringAttr("docker.compose.project", project))
+	}
+	return &resourcepb.Resource{Attributes: attrs}
+}
+
+// post sends an OTLP/HTTP protobuf request
+func (x *OTLPExporter) post(ctx context.Context, path string, msg proto.Message) error {
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, x.opts.Endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	for k, v := range x.opts.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := x.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s: %s: %s", path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// logRecord converts an event to a log record
+func logRecord(evt *event.Event, service string) *logspb.LogRecord {
+	attrs := append(containerAttributes(evt.ContainerName, evt.ContainerID, evt.ImageName, service),
+		stringAttr("event.id", evt.ID),
+		stringAttr("event.name", string(evt.Type)),
+	)
+	keys := make([]string, 0, len(evt.Data))
+	for k := range evt.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		attrs = append(attrs, stringAttr("docksphinx."+k, fmt.Sprint(evt.Data[k])))
+	}
+
+	return &logspb.LogRecord{
+		TimeUnixNano:         uint64(evt.Timestamp.UnixNano()),
+		ObservedTimeUnixNano: uint64(time.Now().UnixNano()),
+		SeverityNumber:       severityNumber(evt.Severity),
+		SeverityText:         strings.ToUpper(evt.Severity.String()),
+		Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: evt.Message}},
+		Attributes:           attrs,
+	}
+}
+
+// severityNumber maps a docksphinx severity to an OTLP severity
+func severityNumber(s event.Severity) logspb.SeverityNumber {
+	switch s {
+	case event.SeverityCritical:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_ERROR
+	case event.SeverityWarning:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_WARN
+	default:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_INFO
+	}
+}
+
+func gauge(name, unit, description string, now uint64, value float64, attrs []*commonpb.KeyValue) *metricspb.Metric {
+	return &metricspb.Metric{
+		Name:        name,
+		Unit:        unit,
+		Description: description,
+		Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+			DataPoints: []*metricspb.NumberDataPoint{{
+				TimeUnixNano: now,
+				Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: value},
+				Attributes:   attrs,
+			}},
+		}},
+	}
+}
+
+func counter(name, unit, description string, points ...*metricspb.NumberDataPoint) *metricspb.Metric {
+	return &metricspb.Metric{
+		Name:        name,
+		Unit:        unit,
+		Description: description,
+		Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+			DataPoints:             points,
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			IsMonotonic:            true,
+		}},
+	}
+}
+
+func directionPoint(now uint64, value float64, direction string, attrs []*commonpb.KeyValue) *metricspb.NumberDataPoint {
+	return &metricspb.NumberDataPoint{
+		TimeUnixNano: now,
+		Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: value},
+		Attributes:   append(append([]*commonpb.KeyValue{}, attrs...), stringAttr("direction", direction)),
+	}
+}
+
+func containerAttributes(name, id, image, service string) []*commonpb.KeyValue {
+	attrs := []*commonpb.KeyValue{
+		stringAttr("container.name", name),
+		stringAttr("container.id", id),
+		stringAttr("container.image.name", image),
+	}
+	if service != "" {
+		attrs = append(attrs, stringAttr("docker.compose.service", service))
+	}
+	return attrs
+}
+
+func stringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
+
+// composeLabels returns the compose project and service of a container from the dependency graph
+func composeLabels(g *graph.Graph, containerID string) (project, service string) {
+	if g == nil || containerID == "" {
+		return "", ""
+	}
+	node, ok := g.Node(containerID)
+	if !ok {
+		return "", ""
+	}
+	return node.Project, node.Service
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
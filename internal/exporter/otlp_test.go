@@ -0,0 +1,137 @@
+package exporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"docksphinx/internal/event"
+	"docksphinx/internal/graph"
+	"docksphinx/internal/monitor"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeCollector is an in-process stand-in for an OTLP/HTTP collector
+type fakeCollector struct {
+	mu      sync.Mutex
+	metrics []*colmetricspb.ExportMetricsServiceRequest
+	logs    []*collogspb.ExportLogsServiceRequest
+	headers []http.Header
+}
+
+func (c *fakeCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.headers = append(c.headers, r.Header.Clone())
+
+	switch r.URL.Path {
+	case "/v1/metrics":
+		req := &colmetricspb.ExportMetricsServiceRequest{}
+		if err := proto.Unmarshal(body, req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		c.metrics = append(c.metrics, req)
+	case "/v1/logs":
+		req := &collogspb.ExportLogsServiceRequest{}
+		if err := proto.Unmarshal(body, req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		c.logs = append(c.logs, req)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func attr(attrs []*commonpb.KeyValue, key string) string {
+	for _, kv := range attrs {
+		if kv.GetKey() == key {
+			return kv.GetValue().GetStringValue()
+		}
+	}
+	return ""
+}
+
+func TestOTLPExporter(t *testing.T) {
+	collector := &fakeCollector{}
+	srv := httptest.NewServer(collector)
+	defer srv.Close()
+
+	engine, err := monitor.NewEngine(monitor.EngineConfig{}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create engine: %v", err)
+	}
+	sm := engine.GetStateManager()
+	sm.UpdateState("abc", &monitor.ContainerState{ContainerID: "abc", ContainerName: "api", State: "running", CPUPercent: 42})
+	sm.UpdateState("def", &monitor.ContainerState{ContainerID: "def", ContainerName: "tool", State: "running"})
+	sm.UpdateDependencyGraph(graph.Build([]graph.Container{{
+		ID:     "abc",
+		Name:   "api",
+		Labels: map[string]string{graph.LabelComposeProject: "shop", graph.LabelComposeService: "api"},
+	}}, nil), time.Now())
+
+	x, err := NewOTLPExporter(OTLPOptions{
+		Endpoint: srv.URL,
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+		Hostname: "testhost",
+	}, engine, nil)
+	if err != nil {
+		t.Fatalf("Failed to create exporter: %v", err)
+	}
+
+	if err := x.ExportMetrics(context.Background()); err != nil {
+		t.Fatalf("ExportMetrics failed: %v", err)
+	}
+	evt := event.NewEvent(event.EventTypeDied, "abc", "api", "api:latest")
+	evt.Severity = event.SeverityCritical
+	evt.Message = "Container api died"
+	if err := x.ExportEvents(context.Background(), []*event.Event{evt}); err != nil {
+		t.Fatalf("ExportEvents failed: %v", err)
+	}
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	if len(collector.metrics) != 1 {
+		t.Fatalf("Expected 1 metrics request, got %d", len(collector.metrics))
+	}
+	resources := collector.metrics[0].GetResourceMetrics()
+	if len(resources) != 2 {
+		t.Fatalf("Expected a resource per compose project (2), got %d", len(resources))
+	}
+	// Sorted by project: standalone containers first
+	shop := resources[1]
+	if got := attr(shop.GetResource().GetAttributes(), "docker.compose.project"); got != "shop" {
+		t.Errorf("Expected compose project 'shop', got %q", got)
+	}
+	if got := attr(shop.GetResource().GetAttributes(), "host.name"); got != "testhost" {
+		t.Errorf("Expected host.name 'testhost', got %q", got)
+	}
+	cpu := shop.GetScopeMetrics()[0].GetMetrics()[0]
+	if cpu.GetName() != "container.cpu.percent" || cpu.GetGauge().GetDataPoints()[0].GetAsDouble() != 42 {
+		t.Errorf("Unexpected CPU metric: %v", cpu)
+	}
+
+	if len(collector.logs) != 1 {
+		t.Fatalf("Expected 1 logs request, got %d", len(collector.logs))
+	}
+	record := collector.logs[0].GetResourceLogs()[0].GetScopeLogs()[0].GetLogRecords()[0]
+	if record.GetBody().GetStringValue() != "Container api died" || record.GetSeverityText() != "CRITICAL" {
+		t.Errorf("Unexpected log record: %v", record)
+	}
+	if attr(record.GetAttributes(), "event.name") != "died" || attr(record.GetAttributes(), "docker.compose.service") != "api" {
+		t.Errorf("Unexpected log attributes: %v", record.GetAttributes())
+	}
+	if collector.headers[0].Get("Authorization") != "Bearer token" {
+		t.Errorf("Expected configured headers to be sent")
+	}
+}
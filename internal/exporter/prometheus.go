@@ -0,0 +1,201 @@
+// Package exporter exports docksphinx metrics and events to external monitoring systems
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"docksphinx/internal/monitor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "docksphinx"
+
+// SubscriberStats provides counters of event subscribers (implemented by the gRPC server)
+type SubscriberStats interface {
+	SubscriberCount() int
+	DroppedEvents() uint64
+}
+
+// PrometheusCollector exposes container states, event counters and engine health as Prometheus metrics.
+// Values are read from the engine on every scrape.
+type PrometheusCollector struct {
+	engine      *monitor.Engine
+	subscribers SubscriberStats // May be nil
+
+	cpuPercent    *prometheus.Desc
+	memoryUsage   *prometheus.Desc
+	memoryLimit   *prometheus.Desc
+	memoryPercent *prometheus.Desc
+	networkRx     *prometheus.Desc
+	networkTx     *prometheus.Desc
+	blockRead     *prometheus.Desc
+	blockWrite    *prometheus.Desc
+	state         *prometheus.Desc
+
+	events              *prometheus.Desc
+	droppedEvents       *prometheus.Desc
+	subscriberDropped   *prometheus.Desc
+	subscriberCount     *prometheus.Desc
+	collections         *prometheus.Desc
+	collectionErrors    *prometheus.Desc
+	collectionDuration  *prometheus.Desc
+	collectionTotalTime *prometheus.Desc
+	containers          *prometheus.Desc
+}
+
+// containerLabels are the labels of per-container metrics
+var containerLabels = []string{"container", "container_id", "image", "compose_project", "compose_service"}
+
+// NewPrometheusCollector creates a collector for the engine. subscribers may be nil.
+func NewPrometheusCollector(engine *monitor.Engine, subscribers SubscriberStats) *PrometheusCollector {
+	desc := func(name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, labels, nil)
+	}
+	container := func(name, help string, extra ...string) *prometheus.Desc {
+		return desc("container_"+name, help, append(append([]string{}, containerLabels...), extra...)...)
+	}
+	return &PrometheusCollector{
+		engine:      engine,
+		subscribers: subscribers,
+
+		cpuPercent:    container("cpu_percent", "CPU usage of the container in percent of one CPU."),
+		memoryUsage:   container("memory_usage_bytes", "Memory usage of the container."),
+		memoryLimit:   container("memory_limit_bytes", "Memory limit of the container."),
+		memoryPercent: container("memory_percent", "Memory usage of the container in percent of its limit."),
+		networkRx:     container("network_receive_bytes_total", "Bytes received by the container."),
+		networkTx:     container("network_transmit_bytes_total", "Bytes sent by the container."),
+		blockRead:     container("block_read_bytes_total", "Bytes read from block devices by the container."),
+		blockWrite:    container("block_write_bytes_total", "Bytes written to block devices by the container."),
+		state:         container("state", "Current state of the container (1 for the current state).", "state"),
+
+		events:              desc("events_total", "Events generated by docksphinx.", "type", "level"),
+		droppedEvents:       desc("events_dropped_total", "Events dropped because the engine event channel was full."),
+		subscriberDropped:   desc("subscriber_events_dropped_total", "Events not delivered to slow stream subscribers."),
+		subscriberCount:     desc("subscribers", "Number of connected stream subscribers."),
+		collections:         desc("collections_total", "Number of container collections."),
+		collectionErrors:    desc("collection_errors_total", "Number of failed container collections."),
+		collectionDuration:  desc("collection_duration_seconds", "Duration of the last container collection."),
+		collectionTotalTime: desc("collection_duration_seconds_total", "Total time spent collecting containers."),
+		containers:          desc("monitored_containers", "Number of containers seen in the last collection."),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *PrometheusCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		c.cpuPercent, c.memoryUsage, c.memoryLimit, c.memoryPercent,
+		c.networkRx, c.networkTx, c.blockRead, c.blockWrite, c.state,
+		c.events, c.droppedEvents, c.subscriberDropped, c.subscriberCount,
+		c.collections, c.collectionErrors, c.collectionDuration, c.collectionTotalTime, c.containers,
+	} {
+		ch <- d
+	}
+}
+
+// Collect implements prometheus.Collector
+func (c *PrometheusCollector) Collect(ch chan<- prometheus.Metric) {
+	sm := c.engine.GetStateManager()
+	depGraph, _ := sm.GetDependencyGraph()
+
+	for id, st := range sm.GetAllStates() {
+		project, service := composeLabels(depGraph, id)
+		labels := []string{st.ContainerName, id, st.ImageName, project, service}
+
+		ch <- prometheus.MustNewConstMetric(c.state, prometheus.GaugeValue, 1, append(labels, st.State)...)
+		if st.State != "running" {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(c.cpuPercent, prometheus.GaugeValue, st.CPUPercent, labels...)
+		ch <- prometheus.MustNewConstMetric(c.memoryUsage, prometheus.GaugeValue, float64(st.MemoryUsage), labels...)
+		ch <- prometheus.MustNewConstMetric(c.memoryLimit, prometheus.GaugeValue, float64(st.MemoryLimit), labels...)
+		ch <- prometheus.MustNewConstMetric(c.memoryPercent, prometheus.GaugeValue, st.MemoryPercent, labels...)
+		ch <- prometheus.MustNewConstMetric(c.networkRx, prometheus.CounterValue, float64(st.NetworkRx), labels...)
+		ch <- prometheus.MustNewConstMetric(c.networkTx, prometheus.CounterValue, float64(st.NetworkTx), labels...)
+		ch <- prometheus.MustNewConstMetric(c.blockRead, prometheus.CounterValue, float64(st.BlockRead), labels...)
+		ch <- prometheus.MustNewConstMetric(c.blockWrite, prometheus.CounterValue, float64(st.BlockWrite), labels...)
+	}
+
+	stats := c.engine.GetStats()
+	for key, n := range stats.EventCounts {
+		ch <- prometheus.MustNewConstMetric(c.events, prometheus.CounterValue, float64(n), string(key.Type), key.Severity.String())
+	}
+	ch <- prometheus.MustNewConstMetric(c.droppedEvents, prometheus.CounterValue, float64(stats.DroppedEvents))
+	ch <- prometheus.MustNewConstMetric(c.collections, prometheus.CounterValue, float64(stats.Collections))
+	ch <- prometheus.MustNewConstMetric(c.collectionErrors, prometheus.CounterValue, float64(stats.CollectionErrors))
+	ch <- prometheus.MustNewConstMetric(c.collectionDuration, prometheus.GaugeValue, stats.LastCollectDuration.Seconds())
+	ch <- prometheus.MustNewConstMetric(c.collectionTotalTime, prometheus.CounterValue, stats.TotalCollectDuration.Seconds())
+	ch <- prometheus.MustNewConstMetric(c.containers, prometheus.GaugeValue, float64(stats.MonitoredContainers))
+
+	if c.subscribers != nil {
+		ch <- prometheus.MustNewConstMetric(c.subscriberCount, prometheus.GaugeValue, float64(c.subscribers.SubscriberCount()))
+		ch <- prometheus.MustNewConstMetric(c.subscriberDropped, prometheus.CounterValue, float64(c.subscribers.DroppedEvents()))
+	}
+}
+
+// PrometheusOptions configures the /metrics HTTP server
+type PrometheusOptions struct {
+	Address string // e.g. "127.0.0.1:9273"
+	Path    string // Default: /metrics
+}
+
+// PrometheusServer serves the Prometheus metrics endpoint
+type PrometheusServer struct {
+	lis    net.Listener
+	server *http.Server
+}
+
+// NewPrometheusServer creates the metrics HTTP server (does not start serving).
+// The registry includes Go runtime and process metrics of the daemon.
+func NewPrometheusServer(opts PrometheusOptions, engine *monitor.Engine, subscribers SubscriberStats) (*PrometheusServer, error) {
+	if opts.Path == "" {
+		opts.Path = "/metrics"
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		NewPrometheusCollector(engine, subscribers),
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle(opts.Path, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
+	lis, err := net.Listen("tcp", opts.Address)
+	if err != nil {
+		return nil, fmt.Errorf("listen %s: %w", opts.Address, err)
+	}
+	return &PrometheusServer{
+		lis:    lis,
+		server: &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second},
+	}, nil
+}
+
+// Addr returns the listening address
+func (s *PrometheusServer) Addr() net.Addr {
+	return s.lis.Addr()
+}
+
+// Start serves the endpoint (blocking). Call from a goroutine.
+func (s *PrometheusServer) Start() error {
+	if err := s.server.Serve(s.lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Stop shuts down the server, waiting for in-flight scrapes up to a few seconds
+func (s *PrometheusServer) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		s.server.Close()
+	}
+}
@@ -0,0 +1,63 @@
+package exporter
+
+import (
+	"testing"
+
+	"docksphinx/internal/monitor"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type fakeSubscribers struct{}
+
+func (fakeSubscribers) SubscriberCount() int  { return 2 }
+func (fakeSubscribers) DroppedEvents() uint64 { return 5 }
+
+func TestPrometheusCollector(t *testing.T) {
+	engine, err := monitor.NewEngine(monitor.EngineConfig{}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create engine: %v", err)
+	}
+	sm := engine.GetStateManager()
+	sm.UpdateState("abc", &monitor.ContainerState{
+		ContainerID:   "abc",
+		ContainerName: "api",
+		ImageName:     "api:latest",
+		State:         "running",
+		CPUPercent:    12.5,
+		MemoryUsage:   1024,
+		NetworkRx:     2048,
+	})
+	sm.UpdateState("def", &monitor.ContainerState{ContainerID: "def", ContainerName: "db", State: "exited"})
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(NewPrometheusCollector(engine, fakeSubscribers{}))
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("Gather failed: %v", err)
+	}
+
+	values := make(map[string][]float64)
+	for _, mf := range families {
+		for _, m := range mf.GetMetric() {
+			switch {
+			case m.GetGauge() != nil:
+				values[mf.GetName()] = append(values[mf.GetName()], m.GetGauge().GetValue())
+			case m.GetCounter() != nil:
+				values[mf.GetName()] = append(values[mf.GetName()], m.GetCounter().GetValue())
+			}
+		}
+	}
+
+	if v := values["docksphinx_container_cpu_percent"]; len(v) != 1 || v[0] != 12.5 {
+		t.Errorf("Expected cpu_percent [12.5] for the running container only, got %v", v)
+	}
+	if v := values["docksphinx_container_network_receive_bytes_total"]; len(v) != 1 || v[0] != 2048 {
+		t.Errorf("Expected network_receive_bytes_total [2048], got %v", v)
+	}
+	if v := values["docksphinx_container_state"]; len(v) != 2 {
+		t.Errorf("Expected a state metric per container, got %v", v)
+	}
+	if v := values["docksphinx_subscribers"]; len(v) != 1 || v[0] != 2 {
+		t.Errorf("Expected 2 subscribers, got %v", v)
+	}
+}
@@ -0,0 +1,220 @@
+package graph
+
+import (
+	"sort"
+	"strings"
+)
+
+// Compose labels used to derive dependencies
+const (
+	LabelComposeProject   = "com.docker.compose.project"
+	LabelComposeService   = "com.docker.compose.service"
+	LabelComposeDependsOn = "com.docker.compose.depends_on"
+)
+
+// Container is the container information needed to build the graph
+type Container struct {
+	ID          string
+	Name        string
+	Image       string
+	State       string
+	Labels      map[string]string
+	Links       []string // HostConfig.Links, e.g. "/db:/web/db"
+	NetworkMode string   // HostConfig.NetworkMode, e.g. "container:db"
+	Volumes     []string // Names of mounted named volumes
+}
+
+// Network is a user-defined network and its attached containers
+type Network struct {
+	Name         string
+	ContainerIDs []string
+}
+
+// Build derives a dependency graph from containers and user-defined networks
+func Build(containers []Container, networks []Network) *Graph {
+	g := &Graph{}
+	r := newResolver(containers)
+	edges := make(map[Edge]bool)
+	addEdge := func(e Edge) {
+		if e.From == "" || e.To == "" || e.From == e.To {
+			return
+		}
+		// Undirected edges are normalized so each pair appears once
+		if !e.Kind.Directed() && e.From > e.To {
+			e.From, e.To = e.To, e.From
+		}
+		edges[e] = true
+	}
+
+	for _, c := range containers {
+		g.Nodes = append(g.Nodes, Node{
+			ID:      c.ID,
+			Name:    c.Name,
+			Image:   c.Image,
+			State:   c.State,
+			Project: c.Labels[LabelComposeProject],
+			Service: c.Labels[LabelComposeService],
+		})
+
+		// compose depends_on: "db:service_started:false,redis:service_healthy:true"
+		project := c.Labels[LabelComposeProject]
+		for _, dep := range parseDependsOn(c.Labels[LabelComposeDependsOn]) {
+			for _, id := range r.byService[serviceKey(project, dep.service)] {
+				addEdge(Edge{From: c.ID, To: id, Kind: EdgeDependsOn, Detail: dep.condition})
+			}
+		}
+
+		// Legacy links: "/db:/web/alias"
+		for _, link := range c.Links {
+			target, alias := parseLink(link)
+			if id := r.resolve(target); id != "" {
+				addEdge(Edge{From: c.ID, To: id, Kind: EdgeLink, Detail: alias})
+			}
+		}
+
+		// network_mode: container:X
+		if target, ok := strings.CutPrefix(c.NetworkMode, "container:"); ok {
+			if id := r.resolve(target); id != "" {
+				addEdge(Edge{From: c.ID, To: id, Kind: EdgeNetworkMode})
+			}
+		}
+	}
+
+	// Shared user-defined networks
+	for _, n := range networks {
+		for i := 0; i < len(n.ContainerIDs); i++ {
+			for j := i + 1; j < len(n.ContainerIDs); j++ {
+				if r.known[n.ContainerIDs[i]] && r.known[n.ContainerIDs[j]] {
+					addEdge(Edge{From: n.ContainerIDs[i], To: n.ContainerIDs[j], Kind: EdgeNetwork, Detail: n.Name})
+				}
+			}
+		}
+	}
+
+	// Shared named volumes
+	volumeUsers := make(map[string][]string)
+	for _, c := range containers {
+		for _, v := range c.Volumes {
+			volumeUsers[v] = append(volumeUsers[v], c.ID)
+		}
+	}
+	for volume, ids := range volumeUsers {
+		for i := 0; i < len(ids); i++ {
+			for j := i + 1; j < len(ids); j++ {
+				addEdge(Edge{From: ids[i], To: ids[j], Kind: EdgeVolume, Detail: volume})
+			}
+		}
+	}
+
+	for e := range edges {
+		g.Edges = append(g.Edges, e)
+	}
+	sort.Slice(g.Nodes, func(i, j int) bool { return g.Nodes[i].Name < g.Nodes[j].Name })
+	sort.Slice(g.Edges, func(i, j int) bool {
+		a, b := g.Edges[i], g.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Detail < b.Detail
+	})
+
+	return g
+}
+
+// resolver maps container names and compose services to container IDs
+type resolver struct {
+	known     map[string]bool     // Container IDs
+	byName    map[string]string   // Name -> ID
+	byService map[string][]string // project/service -> IDs
+	ids       []string
+}
+
+func newResolver(containers []Container) *resolver {
+	r := &resolver{
+		known:     make(map[string]bool),
+		byName:    make(map[string]string),
+		byService: make(map[string][]string),
+	}
+	for _, c := range containers {
+		r.known[c.ID] = true
+		r.byName[c.Name] = c.ID
+		r.ids = append(r.ids, c.ID)
+		if service := c.Labels[LabelComposeService]; service != "" {
+			key := serviceKey(c.Labels[LabelComposeProject], service)
+			r.byService[key] = append(r.byService[key], c.ID)
+		}
+	}
+	return r
+}
+
+// resolve returns the container ID for a name, full ID or unambiguous ID prefix
+func (r *resolver) resolve(ref string) string {
+	ref = strings.TrimPrefix(ref, "/")
+	if ref == "" {
+		return ""
+	}
+	if r.known[ref] {
+		return ref
+	}
+	if id, ok := r.byName[ref]; ok {
+		return id
+	}
+	match := ""
+	for _, id := range r.ids {
+		if strings.HasPrefix(id, ref) {
+			if match != "" {
+				return "" // ambiguous
+			}
+			match = id
+		}
+	}
+	return match
+}
+
+func serviceKey(project, service string) string {
+	return project + "/" + service
+}
+
+type dependsOn struct {
+	service   string
+	condition string
+}
+
+// parseDependsOn parses the compose depends_on label
+// Format: "service:condition:restart[,service:condition:restart...]"
+func parseDependsOn(label string) []dependsOn {
+	if label == "" {
+		return nil
+	}
+	var result []dependsOn
+	for _, entry := range strings.Split(label, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if parts[0] == "" {
+			continue
+		}
+		dep := dependsOn{service: parts[0]}
+		if len(parts) > 1 {
+			dep.condition = parts[1]
+		}
+		result = append(result, dep)
+	}
+	return result
+}
+
+// parseLink parses a HostConfig link "/target:/source/alias" into target name and alias
+func parseLink(link string) (target, alias string) {
+	target, aliasPath, _ := strings.Cut(link, ":")
+	target = strings.TrimPrefix(target, "/")
+	if i := strings.LastIndex(aliasPath, "/"); i >= 0 {
+		alias = aliasPath[i+1:]
+	} else {
+		alias = aliasPath
+	}
+	return target, alias
+}
@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"docksphinx/internal/docker"
+)
+
+// predefinedNetworks are created by Docker itself; sharing them does not imply a relation
+var predefinedNetworks = map[string]bool{
+	"bridge": true,
+	"host":   true,
+	"none":   true,
+}
+
+// Collect builds the dependency graph of the containers selected by opts (stopped ones included)
+// from the Docker daemon. It inspects every selected container and every user-defined network,
+// so it should not run on every tick.
+func Collect(ctx context.Context, client *docker.Client, opts docker.ListContainersOptions) (*Graph, error) {
+	opts.All = true
+	list, err := client.ListContainers(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	containers := make([]Container, 0, len(list))
+	for _, c := range list {
+		inspect, err := client.GetContainer(ctx, c.ID)
+		if err != nil {
+			if docker.IsNotFoundError(err) {
+				continue // removed between list and inspect
+			}
+			return nil, fmt.Errorf("inspect container %s: %w", c.Name, err)
+		}
+
+		gc := Container{
+			ID:    c.ID,
+			Name:  c.Name,
+			Image: c.Image,
+			State: c.State,
+		}
+		if inspect.Config != nil {
+			gc.Labels = inspect.Config.Labels
+		}
+		if inspect.HostConfig != nil {
+			gc.Links = inspect.HostConfig.Links
+			gc.NetworkMode = string(inspect.HostConfig.NetworkMode)
+		}
+		for _, m := range inspect.Mounts {
+			if m.Type == "volume" && m.Name != "" {
+				gc.Volumes = append(gc.Volumes, m.Name)
+			}
+		}
+		containers = append(containers, gc)
+	}
+
+	networkList, err := client.ListNetworks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var networks []Network
+	for _, n := range networkList {
+		if predefinedNetworks[n.Name] {
+			continue
+		}
+		inspect, err := client.GetNetwork(ctx, n.ID)
+		if err != nil {
+			if docker.IsNotFoundError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("inspect network %s: %w", n.Name, err)
+		}
+		network := Network{Name: n.Name}
+		for id := range inspect.Containers {
+			network.ContainerIDs = append(network.ContainerIDs, id)
+		}
+		networks = append(networks, network)
+	}
+
+	return Build(containers, networks), nil
+}
@@ -0,0 +1,190 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// EdgeKind represents why two containers are related
+type EdgeKind string
+
+const (
+	// Directed edges: From depends on To
+	EdgeDependsOn   EdgeKind = "depends_on"   // compose depends_on
+	EdgeLink        EdgeKind = "link"         // legacy --link
+	EdgeNetworkMode EdgeKind = "network_mode" // network_mode: container:X
+
+	// Undirected edges: From and To share a resource (From < To)
+	EdgeNetwork EdgeKind = "network" // shared user-defined network
+	EdgeVolume  EdgeKind = "volume"  // shared named volume
+)
+
+// Directed reports whether the edge kind describes a dependency (From depends on To)
+// rather than a shared resource
+func (k EdgeKind) Directed() bool {
+	switch k {
+	case EdgeDependsOn, EdgeLink, EdgeNetworkMode:
+		return true
+	default:
+		return false
+	}
+}
+
+// Node represents a container in the dependency graph
+type Node struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Image   string `json:"image,omitempty"`
+	State   string `json:"state,omitempty"`
+	Project string `json:"project,omitempty"` // compose project
+	Service string `json:"service,omitempty"` // compose service
+}
+
+// Edge represents a relation between two containers
+type Edge struct {
+	From   string   `json:"from"` // Container ID
+	To     string   `json:"to"`   // Container ID
+	Kind   EdgeKind `json:"kind"`
+	Detail string   `json:"detail,omitempty"` // Network/volume name, depends_on condition, link alias
+}
+
+// Graph is a container dependency graph
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+}
+
+// Node returns the node with the given container ID
+func (g *Graph) Node(id string) (Node, bool) {
+	for _, n := range g.Nodes {
+		if n.ID == id {
+			return n, true
+		}
+	}
+	return Node{}, false
+}
+
+// Dependents returns IDs of containers that directly depend on the given container (incoming directed edges).
+// Shared networks and volumes are not dependencies: containers on a compose default network would otherwise
+// all depend on each other.
+func (g *Graph) Dependents(id string) []string {
+	return g.neighbors(id, func(e Edge) (string, bool) { return e.From, e.To == id })
+}
+
+// Dependencies returns IDs of containers the given container directly depends on (outgoing directed edges)
+func (g *Graph) Dependencies(id string) []string {
+	return g.neighbors(id, func(e Edge) (string, bool) { return e.To, e.From == id })
+}
+
+// neighbors returns the sorted, distinct containers selected by match from the directed edges
+func (g *Graph) neighbors(id string, match func(Edge) (string, bool)) []string {
+	seen := make(map[string]bool)
+	var result []string
+	for _, e := range g.Edges {
+		if !e.Kind.Directed() {
+			continue
+		}
+		if other, ok := match(e); ok && other != id && !seen[other] {
+			seen[other] = true
+			result = append(result, other)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Subgraph returns the connected component containing the given container.
+// Returns an empty graph if the container is unknown.
+func (g *Graph) Subgraph(id string) *Graph {
+	if _, ok := g.Node(id); !ok {
+		return &Graph{}
+	}
+
+	adjacent := make(map[string][]string)
+	for _, e := range g.Edges {
+		adjacent[e.From] = append(adjacent[e.From], e.To)
+		adjacent[e.To] = append(adjacent[e.To], e.From)
+	}
+
+	visited := map[string]bool{id: true}
+	queue := []string{id}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, next := range adjacent[cur] {
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	sub := &Graph{}
+	for _, n := range g.Nodes {
+		if visited[n.ID] {
+			sub.Nodes = append(sub.Nodes, n)
+		}
+	}
+	for _, e := range g.Edges {
+		if visited[e.From] && visited[e.To] {
+			sub.Edges = append(sub.Edges, e)
+		}
+	}
+	return sub
+}
+
+// JSON renders the graph as indented JSON
+func (g *Graph) JSON() ([]byte, error) {
+	out := *g
+	if out.Nodes == nil {
+		out.Nodes = []Node{}
+	}
+	if out.Edges == nil {
+		out.Edges = []Edge{}
+	}
+	return json.MarshalIndent(out, "", "  ")
+}
+
+// DOT renders the graph in Graphviz DOT format.
+// Dependency edges point from the dependent to its dependency; shared resources are dashed and undirected.
+func (g *Graph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph docksphinx {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+
+	for _, n := range g.Nodes {
+		label := n.Name
+		if n.Service != "" && n.Service != n.Name {
+			label = fmt.Sprintf("%s\\n(%s)", n.Name, n.Service)
+		}
+		attrs := fmt.Sprintf("label=%s", quoteDOT(label))
+		if n.State != "" && n.State != "running" {
+			attrs += ", style=dashed"
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", quoteDOT(n.ID), attrs)
+	}
+
+	for _, e := range g.Edges {
+		label := string(e.Kind)
+		if e.Detail != "" {
+			label += ":" + e.Detail
+		}
+		attrs := fmt.Sprintf("label=%s", quoteDOT(label))
+		if !e.Kind.Directed() {
+			attrs += ", dir=none, style=dashed"
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", quoteDOT(e.From), quoteDOT(e.To), attrs)
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// quoteDOT quotes a DOT identifier
+func quoteDOT(s string) string {
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,114 @@
+package graph
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func composeLabels(project, service, dependsOn string) map[string]string {
+	labels := map[string]string{
+		LabelComposeProject: project,
+		LabelComposeService: service,
+	}
+	if dependsOn != "" {
+		labels[LabelComposeDependsOn] = dependsOn
+	}
+	return labels
+}
+
+func TestBuild(t *testing.T) {
+	containers := []Container{
+		{ID: "aaa111", Name: "shop-db-1", State: "running", Labels: composeLabels("shop", "db", ""), Volumes: []string{"dbdata"}},
+		{ID: "bbb222", Name: "shop-api-1", State: "running", Labels: composeLabels("shop", "api", "db:service_healthy:false"), Volumes: []string{"dbdata"}},
+		{ID: "ccc333", Name: "legacy", State: "running", Links: []string{"/shop-db-1:/legacy/database"}},
+		{ID: "ddd444", Name: "sidecar", State: "running", NetworkMode: "container:bbb"},
+		{ID: "eee555", Name: "other-db-1", State: "running", Labels: composeLabels("other", "db", "")},
+	}
+	networks := []Network{
+		{Name: "shop_default", ContainerIDs: []string{"bbb222", "aaa111", "unknown"}},
+	}
+
+	g := Build(containers, networks)
+
+	if len(g.Nodes) != 5 {
+		t.Fatalf("Expected 5 nodes, got %d", len(g.Nodes))
+	}
+
+	want := []Edge{
+		{From: "aaa111", To: "bbb222", Kind: EdgeNetwork, Detail: "shop_default"},
+		{From: "aaa111", To: "bbb222", Kind: EdgeVolume, Detail: "dbdata"},
+		{From: "bbb222", To: "aaa111", Kind: EdgeDependsOn, Detail: "service_healthy"},
+		{From: "ccc333", To: "aaa111", Kind: EdgeLink, Detail: "database"},
+		{From: "ddd444", To: "bbb222", Kind: EdgeNetworkMode},
+	}
+	if len(g.Edges) != len(want) {
+		t.Fatalf("Expected %d edges, got %d: %+v", len(want), len(g.Edges), g.Edges)
+	}
+	for i := range want {
+		if g.Edges[i] != want[i] {
+			t.Errorf("Edge %d: expected %+v, got %+v", i, want[i], g.Edges[i])
+		}
+	}
+
+	dependents := g.Dependents("aaa111")
+	if strings.Join(dependents, ",") != "bbb222,ccc333" {
+		t.Errorf("Expected dependents bbb222,ccc333, got %v", dependents)
+	}
+
+	// db shares a network and a volume with api, which does not make it a dependent
+	if dependents := g.Dependents("bbb222"); strings.Join(dependents, ",") != "ddd444" {
+		t.Errorf("Expected dependents ddd444, got %v", dependents)
+	}
+
+	dependencies := g.Dependencies("bbb222")
+	if strings.Join(dependencies, ",") != "aaa111" {
+		t.Errorf("Expected dependencies aaa111, got %v", dependencies)
+	}
+
+	sub := g.Subgraph("ddd444")
+	if len(sub.Nodes) != 4 {
+		t.Errorf("Expected 4 nodes in subgraph, got %d", len(sub.Nodes))
+	}
+	if len(g.Subgraph("eee555").Edges) != 0 {
+		t.Error("Expected isolated container to have no edges")
+	}
+}
+
+func TestExport(t *testing.T) {
+	g := Build([]Container{
+		{ID: "aaa111", Name: "db", State: "exited"},
+		{ID: "bbb222", Name: "api", State: "running", Links: []string{"/db:/api/db"}},
+	}, nil)
+
+	dot := g.DOT()
+	if !strings.HasPrefix(dot, "digraph docksphinx {") {
+		t.Errorf("Unexpected DOT header: %q", dot)
+	}
+	if !strings.Contains(dot, `"bbb222" -> "aaa111" [label="link:db"];`) {
+		t.Errorf("Expected link edge in DOT output:\n%s", dot)
+	}
+	if !strings.Contains(dot, `"aaa111" [label="db", style=dashed];`) {
+		t.Errorf("Expected stopped container to be dashed:\n%s", dot)
+	}
+
+	data, err := g.JSON()
+	if err != nil {
+		t.Fatalf("Failed to render JSON: %v", err)
+	}
+	var decoded Graph
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to decode JSON: %v", err)
+	}
+	if len(decoded.Nodes) != 2 || len(decoded.Edges) != 1 {
+		t.Errorf("Expected 2 nodes and 1 edge, got %d and %d", len(decoded.Nodes), len(decoded.Edges))
+	}
+
+	empty, err := (&Graph{}).JSON()
+	if err != nil {
+		t.Fatalf("Failed to render empty graph: %v", err)
+	}
+	if !strings.Contains(string(empty), `"nodes": []`) {
+		t.Errorf("Expected empty node list, got %s", empty)
+	}
+}
@@ -0,0 +1,276 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	pb "docksphinx/api/docksphinx/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Role grants access to a set of RPCs
+type Role string
+
+const (
+	RoleReadOnly Role = "read-only" // Snapshots, streams, history and listings
+	RoleAdmin    Role = "admin"     // Everything, including RPCs that change daemon state
+)
+
+// ParseRole returns the role with the given name
+func ParseRole(name string) (Role, error) {
+	switch r := Role(name); r {
+	case RoleReadOnly, RoleAdmin:
+		return r, nil
+	}
+	return "", fmt.Errorf("unknown role %q (want read-only or admin)", name)
+}
+
+// adminMethods are the RPCs that require RoleAdmin; all others require RoleReadOnly
+var adminMethods = map[string]bool{
+	pb.DocksphinxService_CreateSilence_FullMethodName: true,
+}
+
+// publicMethods need no token, so that probes and load balancers can check health
+var publicMethods = map[string]bool{
+	healthpb.Health_Check_FullMethodName: true,
+	healthpb.Health_Watch_FullMethodName: true,
+}
+
+// Token is a bearer token granting a role
+type Token struct {
+	Name  string // Identifies the holder in the audit log
+	Token string
+	Role  Role
+}
+
+// AuthOptions enables bearer-token authentication
+type AuthOptions struct {
+	Tokens []Token
+	// Role of Unix socket clients that send no token (empty: a token is required)
+	SocketRole Role
+}
+
+// identity is an authenticated caller
+type identity struct {
+	name string
+	role Role
+}
+
+// authenticator checks bearer tokens and records admin calls.
+// Without AuthOptions every caller is an anonymous admin.
+type authenticator struct {
+	enabled    bool
+	tokens     map[[sha256.Size]byte]identity // Key: hash of the token
+	socketRole Role
+	audit      *auditLog
+}
+
+// newAuthenticator creates an authenticator; auditPath is the file admin calls are appended to (empty: not recorded)
+func newAuthenticator(opts *AuthOptions, auditPath string) (*authenticator, error) {
+	a := &authenticator{tokens: make(map[[sha256.Size]byte]identity)}
+	if auditPath != "" {
+		a.audit = &auditLog{path: auditPath}
+	}
+	if opts == nil {
+		return a, nil
+	}
+	a.enabled = true
+	a.socketRole = opts.SocketRole
+	for _, t := range opts.Tokens {
+		if t.Token == "" {
+			return nil, fmt.Errorf("token %s is empty", t.Name)
+		}
+		if _, err := ParseRole(string(t.Role)); err != nil {
+			return nil, fmt.Errorf("token %s: %w", t.Name, err)
+		}
+		a.tokens[sha256.Sum256([]byte(t.Token))] = identity{name: t.Name, role: t.Role}
+	}
+	return a, nil
+}
+
+// authenticate resolves the caller of an RPC from its bearer token or transport
+func (a *authenticator) authenticate(ctx context.Context) (identity, error) {
+	if !a.enabled {
+		return identity{role: RoleAdmin}, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		if a.socketRole != "" && peerNetwork(ctx) == "unix" {
+			return identity{name: "unix-socket", role: a.socketRole}, nil
+		}
+		return identity{}, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok {
+		return identity{}, status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+	}
+	// Looking up the hash keeps the timing of the lookup independent of the token contents
+	id, ok := a.tokens[sha256.Sum256([]byte(token))]
+	if !ok {
+		return identity{}, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+	return id, nil
+}
+
+// authorize checks that the caller's role allows method
+func authorize(id identity, method string) error {
+	if adminMethods[method] && id.role != RoleAdmin {
+		return status.Errorf(codes.PermissionDenied, "%s requires the admin role", method)
+	}
+	return nil
+}
+
+// unaryInterceptor authenticates and authorizes unary RPCs and audits admin calls
+func (a *authenticator) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if publicMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	id, err := a.authenticate(ctx)
+	if err == nil {
+		err = authorize(id, info.FullMethod)
+	}
+	if err != nil {
+		a.record(ctx, id, info.FullMethod, req, err)
+		return nil, err
+	}
+	resp, err := handler(ctx, req)
+	a.record(ctx, id, info.FullMethod, req, err)
+	return resp, err
+}
+
+// streamInterceptor authenticates and authorizes streaming RPCs
+func (a *authenticator) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if publicMethods[info.FullMethod] {
+		return handler(srv, ss)
+	}
+	id, err := a.authenticate(ss.Context())
+	if err == nil {
+		err = authorize(id, info.FullMethod)
+	}
+	if err != nil {
+		a.record(ss.Context(), id, info.FullMethod, nil, err)
+		return err
+	}
+	err = handler(srv, ss)
+	a.record(ss.Context(), id, info.FullMethod, nil, err)
+	return err
+}
+
+// record writes an admin call to the audit log
+func (a *authenticator) record(ctx context.Context, id identity, method string, req any, callErr error) {
+	if a.audit == nil || !adminMethods[method] {
+		return
+	}
+	entry := auditRecord{
+		Time:       time.Now(),
+		Name:       id.name,
+		Role:       string(id.role),
+		Peer:       peerAddr(ctx),
+		ClientCert: peerCertName(ctx),
+		Method:     method,
+		Code:       status.Code(callErr).String(),
+	}
+	if callErr != nil {
+		entry.Error = status.Convert(callErr).Message()
+	}
+	if msg, ok := req.(proto.Message); ok {
+		if data, err := protojson.Marshal(msg); err == nil {
+			entry.Request = data
+		}
+	}
+	if err := a.audit.write(entry); err != nil {
+		fmt.Printf("Error writing audit log: %v\n", err)
+	}
+}
+
+// auditRecord is a line of the audit log
+type auditRecord struct {
+	Time       time.Time       `json:"time"`
+	Name       string          `json:"name,omitempty"` // Token name, empty if unauthenticated
+	Role       string          `json:"role,omitempty"`
+	Peer       string          `json:"peer"`
+	ClientCert string          `json:"client_cert,omitempty"` // Common name of the verified client certificate (mutual TLS)
+	Method     string          `json:"method"`
+	Code       string          `json:"code"`
+	Error      string          `json:"error,omitempty"`
+	Request    json.RawMessage `json:"request,omitempty"`
+}
+
+// auditLog appends records to a JSON lines file
+type auditLog struct {
+	mu   sync.Mutex
+	path string
+}
+
+func (l *auditLog) write(r auditRecord) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("marshal audit record: %w", err)
+	}
+	data = append(data, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o700); err != nil {
+		return fmt.Errorf("create audit log directory: %w", err)
+	}
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("open audit log: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		return fmt.Errorf("write audit log: %w", err)
+	}
+	return nil
+}
+
+// peerNetwork returns the network of the caller's connection, e.g. "unix" or "tcp"
+func peerNetwork(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.Network()
+	}
+	return ""
+}
+
+// peerAddr returns the caller's address for the audit log
+func peerAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if p.Addr.Network() == "unix" {
+		return "unix"
+	}
+	return p.Addr.String()
+}
+
+// peerCertName returns the common name of the caller's verified client certificate
+func peerCertName(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return info.State.VerifiedChains[0][0].Subject.CommonName
+}
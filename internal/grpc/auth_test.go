@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "docksphinx/api/docksphinx/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	audit := filepath.Join(t.TempDir(), "audit.jsonl")
+	auth, err := newAuthenticator(&AuthOptions{
+		Tokens: []Token{
+			{Name: "alice", Token: "admin-secret", Role: RoleAdmin},
+			{Name: "bob", Token: "viewer-secret", Role: RoleReadOnly},
+		},
+		SocketRole: RoleReadOnly,
+	}, audit)
+	if err != nil {
+		t.Fatalf("Failed to create authenticator: %v", err)
+	}
+
+	tcp := &net.TCPAddr{IP: net.IPv4(192, 0, 2, 1), Port: 40000}
+	unix := &net.UnixAddr{Name: "/run/docksphinx.sock", Net: "unix"}
+	call := func(addr net.Addr, token, method string) codes.Code {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+		}
+		_, err := auth.unaryInterceptor(ctx, &pb.CreateSilenceRequest{Comment: "deploy"}, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req any) (any, error) { return nil, nil })
+		return status.Code(err)
+	}
+
+	snapshot := pb.DocksphinxService_GetSnapshot_FullMethodName
+	silence := pb.DocksphinxService_CreateSilence_FullMethodName
+	healthCheck := healthpb.Health_Check_FullMethodName
+	tests := []struct {
+		name   string
+		addr   net.Addr
+		token  string
+		method string
+		want   codes.Code
+	}{
+		{"no token over TCP", tcp, "", snapshot, codes.Unauthenticated},
+		{"health check without token", tcp, "", healthCheck, codes.OK},
+		{"wrong token", tcp, "guess", snapshot, codes.Unauthenticated},
+		{"read-only reads", tcp, "viewer-secret", snapshot, codes.OK},
+		{"read-only silences", tcp, "viewer-secret", silence, codes.PermissionDenied},
+		{"admin silences", tcp, "admin-secret", silence, codes.OK},
+		{"socket without token reads", unix, "", snapshot, codes.OK},
+		{"socket without token silences", unix, "", silence, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		if got := call(tt.addr, tt.token, tt.method); got != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.name, tt.want, got)
+		}
+	}
+
+	data, err := os.ReadFile(audit)
+	if err != nil {
+		t.Fatalf("Failed to read audit log: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 audited admin calls, got %d:\n%s", len(lines), data)
+	}
+	var rec auditRecord
+	if err := json.Unmarshal([]byte(lines[1]), &rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Name != "alice" || rec.Code != "OK" || rec.Peer != tcp.String() || !strings.Contains(string(rec.Request), "deploy") {
+		t.Errorf("Unexpected audit record: %+v", rec)
+	}
+}
+
+func TestIsLocalAddress(t *testing.T) {
+	for address, want := range map[string]bool{
+		"unix:///run/docksphinx.sock": true,
+		"127.0.0.1:50051":             true,
+		"localhost:50051":             true,
+		"[::1]:50051":                 true,
+		"dns:///localhost:50051":      true,
+		":50051":                      false,
+		"0.0.0.0:50051":               false,
+		"192.0.2.1:50051":             false,
+		"docksphinx.example.com:443":  false,
+	} {
+		if got := IsLocalAddress(address); got != want {
+			t.Errorf("IsLocalAddress(%q) = %v, want %v", address, got, want)
+		}
+	}
+}
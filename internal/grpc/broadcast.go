@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"docksphinx/internal/event"
 )
@@ -12,6 +13,7 @@ const subscriberChanBuf = 32
 type Broadcaster struct {
 	mu          sync.RWMutex
 	subscribers map[chan *event.Event]struct{}
+	dropped     atomic.Uint64
 }
 
 // NewBroadcaster creates a new Broadcaster
@@ -53,6 +55,7 @@ func (b *Broadcaster) Send(ev *event.Event) {
 		case ch <- ev:
 		default:
 			// subscriber too slow; skip this subscriber for this event
+			b.dropped.Add(1)
 		}
 	}
 }
@@ -63,3 +66,15 @@ func (b *Broadcaster) Run(src <-chan *event.Event) {
 		b.Send(ev)
 	}
 }
+
+// SubscriberCount returns the number of current subscribers
+func (b *Broadcaster) SubscriberCount() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscribers)
+}
+
+// Dropped returns the number of events skipped for slow subscribers
+func (b *Broadcaster) Dropped() uint64 {
+	return b.dropped.Load()
+}
@@ -6,8 +6,12 @@ import (
 	"time"
 
 	pb "docksphinx/api/docksphinx/v1"
+	"docksphinx/internal/alert"
+	"docksphinx/internal/docker"
 	"docksphinx/internal/event"
+	"docksphinx/internal/graph"
 	"docksphinx/internal/monitor"
+	"docksphinx/internal/tsdb"
 )
 
 // EventToProto converts internal event to proto Event
@@ -19,7 +23,11 @@ func EventToProto(ev *event.Event) *pb.Event {
 	for k, v := range ev.Data {
 		data[k] = fmtString(v)
 	}
-	return &pb.Event{
+	var logs []*pb.LogLine
+	for _, line := range ev.Logs {
+		logs = append(logs, LogLineToProto(docker.LogLine(line)))
+	}
+	result := &pb.Event{
 		Id:            ev.ID,
 		Type:          string(ev.Type),
 		TimestampUnix: ev.Timestamp.Unix(),
@@ -28,6 +36,100 @@ func EventToProto(ev *event.Event) *pb.Event {
 		ImageName:     ev.ImageName,
 		Message:       ev.Message,
 		Data:          data,
+		Logs:          logs,
+		Severity:      SeverityToProto(ev.Severity),
+	}
+	setPayload(result, ev.Payload)
+	return result
+}
+
+// setPayload sets the typed payload oneof of a proto event
+func setPayload(result *pb.Event, payload event.Payload) {
+	switch p := payload.(type) {
+	case *event.StateChangePayload:
+		result.Payload = &pb.Event_StateChange{StateChange: &pb.StateChangePayload{
+			PreviousState:        p.PreviousState,
+			TimeSinceStopSeconds: p.TimeSinceStop.Seconds(),
+			Dependents:           p.Dependents,
+			DependentIds:         p.DependentIDs,
+			ExitCode:             int32(p.ExitCode),
+		}}
+	case *event.CrashLoopPayload:
+		result.Payload = &pb.Event_CrashLoop{CrashLoop: &pb.CrashLoopPayload{
+			Crashes:       int32(p.Crashes),
+			WindowSeconds: p.Window.Seconds(),
+			ExitCode:      int32(p.ExitCode),
+		}}
+	case *event.ThresholdPayload:
+		result.Payload = &pb.Event_Threshold{Threshold: &pb.ThresholdPayload{
+			Metric:           p.Metric,
+			Value:            p.Value,
+			Threshold:        p.Threshold,
+			ConsecutiveCount: int32(p.ConsecutiveCount),
+		}}
+	case *event.VolumeGrowthPayload:
+		result.Payload = &pb.Event_VolumeGrowth{VolumeGrowth: &pb.VolumeGrowthPayload{
+			VolumeName:    p.VolumeName,
+			Size:          p.Size,
+			PreviousSize:  p.PreviousSize,
+			GrowthBytes:   p.GrowthBytes,
+			GrowthPercent: p.GrowthPercent,
+			RefCount:      p.RefCount,
+		}}
+	case *event.LogMatchPayload:
+		result.Payload = &pb.Event_LogMatch{LogMatch: &pb.LogMatchPayload{
+			Pattern:       p.Pattern,
+			Line:          p.Line,
+			Stream:        p.Stream,
+			MatchCount:    int32(p.MatchCount),
+			WindowSeconds: p.Window.Seconds(),
+			Suppressed:    int32(p.Suppressed),
+			Context:       p.Context,
+		}}
+	case *event.ImpactPayload:
+		result.Payload = &pb.Event_Impact{Impact: &pb.ImpactPayload{
+			RootCauseFailedAtUnix: p.RootCauseFailedAt.Unix(),
+			Affected:              p.Affected,
+			AffectedIds:           p.AffectedIDs,
+			WindowSeconds:         p.Window.Seconds(),
+		}}
+	case *event.GroupPayload:
+		result.Payload = &pb.Event_Group{Group: &pb.GroupPayload{
+			Group:      p.Group,
+			Count:      int32(p.Count),
+			Containers: p.Containers,
+		}}
+	case *event.DockerConnectionPayload:
+		result.Payload = &pb.Event_DockerConnection{DockerConnection: &pb.DockerConnectionPayload{
+			Error:           p.Error,
+			DowntimeSeconds: p.Downtime.Seconds(),
+			Attempts:        int32(p.Attempts),
+			ContainerCount:  int32(p.Containers),
+		}}
+	}
+}
+
+// SeverityToProto converts an event severity to its proto enum
+func SeverityToProto(s event.Severity) pb.Severity {
+	switch s {
+	case event.SeverityCritical:
+		return pb.Severity_SEVERITY_CRITICAL
+	case event.SeverityWarning:
+		return pb.Severity_SEVERITY_WARNING
+	default:
+		return pb.Severity_SEVERITY_INFO
+	}
+}
+
+// SeverityFromProto converts a proto severity filter; unspecified maps to info, which matches all events
+func SeverityFromProto(s pb.Severity) event.Severity {
+	switch s {
+	case pb.Severity_SEVERITY_CRITICAL:
+		return event.SeverityCritical
+	case pb.Severity_SEVERITY_WARNING:
+		return event.SeverityWarning
+	default:
+		return event.SeverityInfo
 	}
 }
 
@@ -49,6 +151,13 @@ func fmtString(v interface{}) string {
 // StateToSnapshot builds proto Snapshot from StateManager
 func StateToSnapshot(sm *monitor.StateManager) *pb.Snapshot {
 	states := sm.GetAllStates()
+	du, duAt := sm.GetDiskUsage()
+	containerSizes := make(map[string]docker.ContainerDiskUsage)
+	if du != nil {
+		for _, c := range du.Containers {
+			containerSizes[c.ID] = c
+		}
+	}
 	containers := make([]*pb.ContainerInfo, 0, len(states))
 	metrics := make(map[string]*pb.ContainerMetrics)
 	for _, st := range states {
@@ -68,11 +177,274 @@ func StateToSnapshot(sm *monitor.StateManager) *pb.Snapshot {
 			MemoryPercent: st.MemoryPercent,
 			NetworkRx:     st.NetworkRx,
 			NetworkTx:     st.NetworkTx,
+			SizeRw:        containerSizes[st.ContainerID].SizeRw,
+			SizeRootFs:    containerSizes[st.ContainerID].SizeRootFs,
 		}
 	}
+	inventory := sm.GetInventory()
 	return &pb.Snapshot{
 		Containers: containers,
 		Metrics:    metrics,
 		AtUnix:     time.Now().Unix(),
+		Images:     ImagesToProto(inventory.Images),
+		Networks:   NetworksToProto(inventory.Networks),
+		Volumes:    VolumesToProto(inventory.Volumes, du),
+		DiskUsage:  DiskUsageToProto(du, duAt),
+	}
+}
+
+// DiskUsageToProto converts docker disk usage to proto DiskUsage (nil if not collected yet)
+func DiskUsageToProto(du *docker.DiskUsage, collectedAt time.Time) *pb.DiskUsage {
+	if du == nil {
+		return nil
+	}
+	return &pb.DiskUsage{
+		LayersSize:      du.LayersSize,
+		ContainersSize:  du.ContainersSize,
+		VolumesSize:     du.VolumesSize,
+		BuildCacheSize:  du.BuildCacheSize,
+		CollectedAtUnix: collectedAtUnix(collectedAt),
+	}
+}
+
+// ImagesToProto converts docker images to proto ImageInfo
+func ImagesToProto(images []docker.Image) []*pb.ImageInfo {
+	result := make([]*pb.ImageInfo, 0, len(images))
+	for _, img := range images {
+		result = append(result, &pb.ImageInfo{
+			Id:          img.ID,
+			Repository:  img.Repository,
+			Tag:         img.Tag,
+			Size:        img.Size,
+			CreatedUnix: img.Created,
+			VirtualSize: img.VirtualSize,
+		})
+	}
+	return result
+}
+
+// NetworksToProto converts docker networks to proto NetworkInfo
+func NetworksToProto(networks []docker.Network) []*pb.NetworkInfo {
+	result := make([]*pb.NetworkInfo, 0, len(networks))
+	for _, n := range networks {
+		result = append(result, &pb.NetworkInfo{
+			Id:       n.ID,
+			Name:     n.Name,
+			Driver:   n.Driver,
+			Scope:    n.Scope,
+			Internal: n.Internal,
+			Labels:   n.Labels,
+		})
+	}
+	return result
+}
+
+// VolumesToProto converts docker volumes to proto VolumeInfo.
+// Size and ref count are taken from du and set to -1 if unknown.
+func VolumesToProto(volumes []docker.Volume, du *docker.DiskUsage) []*pb.VolumeInfo {
+	usage := make(map[string]docker.VolumeDiskUsage)
+	if du != nil {
+		for _, v := range du.Volumes {
+			usage[v.Name] = v
+		}
+	}
+	result := make([]*pb.VolumeInfo, 0, len(volumes))
+	for _, v := range volumes {
+		info := &pb.VolumeInfo{
+			Name:       v.Name,
+			Driver:     v.Driver,
+			Mountpoint: v.Mountpoint,
+			Labels:     v.Labels,
+			Size:       -1,
+			RefCount:   -1,
+		}
+		if u, ok := usage[v.Name]; ok {
+			info.Size = u.Size
+			info.RefCount = u.RefCount
+		}
+		result = append(result, info)
+	}
+	return result
+}
+
+// collectedAtUnix returns 0 when the inventory has not been collected yet
+func collectedAtUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// GraphToProto converts a dependency graph to proto DependencyGraph
+func GraphToProto(g *graph.Graph, collectedAt time.Time) *pb.DependencyGraph {
+	result := &pb.DependencyGraph{
+		Nodes:           make([]*pb.GraphNode, 0, len(g.Nodes)),
+		Edges:           make([]*pb.GraphEdge, 0, len(g.Edges)),
+		CollectedAtUnix: collectedAtUnix(collectedAt),
+	}
+	for _, n := range g.Nodes {
+		result.Nodes = append(result.Nodes, &pb.GraphNode{
+			ContainerId:    n.ID,
+			ContainerName:  n.Name,
+			ImageName:      n.Image,
+			State:          n.State,
+			ComposeProject: n.Project,
+			ComposeService: n.Service,
+		})
+	}
+	for _, e := range g.Edges {
+		result.Edges = append(result.Edges, &pb.GraphEdge{
+			From:     e.From,
+			To:       e.To,
+			Kind:     string(e.Kind),
+			Detail:   e.Detail,
+			Directed: e.Kind.Directed(),
+		})
+	}
+	return result
+}
+
+// LogLineToProto converts a docker log line to proto LogLine
+func LogLineToProto(line docker.LogLine) *pb.LogLine {
+	var ts int64
+	if !line.Timestamp.IsZero() {
+		ts = line.Timestamp.UnixNano()
+	}
+	return &pb.LogLine{
+		Stream:            line.Stream,
+		TimestampUnixNano: ts,
+		Text:              line.Text,
+	}
+}
+
+// LogsRequestToOptions converts a proto GetLogsRequest to docker LogsOptions
+func LogsRequestToOptions(req *pb.GetLogsRequest) docker.LogsOptions {
+	opts := docker.LogsOptions{
+		Tail:   int(req.GetTail()),
+		Stdout: req.GetStdout(),
+		Stderr: req.GetStderr(),
+	}
+	if req.GetSinceUnix() > 0 {
+		opts.Since = time.Unix(req.GetSinceUnix(), 0)
+	}
+	if req.GetUntilUnix() > 0 {
+		opts.Until = time.Unix(req.GetUntilUnix(), 0)
+	}
+	return opts
+}
+
+// MetricHistoryToProto converts the metric samples of a container state to proto
+func MetricHistoryToProto(state *monitor.ContainerState) *pb.ContainerMetricHistory {
+	h := &pb.ContainerMetricHistory{
+		ContainerId:   state.ContainerID,
+		ContainerName: state.ContainerName,
+	}
+	if state.History == nil {
+		return h
+	}
+	for _, sample := range state.History.Samples() {
+		h.Samples = append(h.Samples, &pb.MetricSample{
+			TimestampUnix:  sample.Timestamp.Unix(),
+			CpuPercent:     sample.CPUPercent,
+			MemoryUsage:    sample.MemoryUsage,
+			MemoryPercent:  sample.MemoryPercent,
+			NetworkRxRate:  sample.NetworkRxRate,
+			NetworkTxRate:  sample.NetworkTxRate,
+			BlockReadRate:  sample.BlockReadRate,
+			BlockWriteRate: sample.BlockWriteRate,
+		})
+	}
+	return h
+}
+
+// QueryMetricsRequestToQuery converts a QueryMetrics request to a metric store query
+func QueryMetricsRequestToQuery(req *pb.QueryMetricsRequest) (tsdb.Query, error) {
+	q := tsdb.Query{
+		ContainerID: req.GetContainerId(),
+		Start:       time.Unix(req.GetStartUnix(), 0),
+		Step:        time.Duration(req.GetStepSeconds()) * time.Second,
+	}
+	if req.GetStartUnix() <= 0 {
+		return q, fmt.Errorf("start_unix is required")
+	}
+	if req.GetEndUnix() > 0 {
+		q.End = time.Unix(req.GetEndUnix(), 0)
+	}
+	for _, name := range req.GetMetrics() {
+		m, err := tsdb.ParseMetric(name)
+		if err != nil {
+			return q, err
+		}
+		q.Metrics = append(q.Metrics, m)
+	}
+	return q, nil
+}
+
+// QueryResultToProto converts a metric store query result to proto
+func QueryResultToProto(result *tsdb.Result) *pb.QueryMetricsResponse {
+	resp := &pb.QueryMetricsResponse{
+		Resolution:  result.Resolution,
+		StepSeconds: int64(result.Step / time.Second),
+		Series:      make([]*pb.MetricSeries, 0, len(result.Series)),
+	}
+	for _, s := range result.Series {
+		series := &pb.MetricSeries{
+			ContainerId:   s.ContainerID,
+			ContainerName: s.ContainerName,
+			Metric:        s.Metric.String(),
+			Points:        make([]*pb.MetricPoint, 0, len(s.Points)),
+		}
+		for _, p := range s.Points {
+			series.Points = append(series.Points, &pb.MetricPoint{
+				TimestampUnix: p.Time.Unix(),
+				Min:           p.Min,
+				Max:           p.Max,
+				Avg:           p.Avg,
+				Count:         int64(p.Count),
+			})
+		}
+		resp.Series = append(resp.Series, series)
+	}
+	return resp
+}
+
+// SilenceToProto converts a silence to proto
+func SilenceToProto(s alert.Silence) *pb.Silence {
+	types := make([]string, 0, len(s.EventTypes))
+	for _, t := range s.EventTypes {
+		types = append(types, string(t))
+	}
+	return &pb.Silence{
+		Id:            s.ID,
+		Container:     s.Container,
+		Labels:        s.Labels,
+		EventTypes:    types,
+		Comment:       s.Comment,
+		CreatedAtUnix: s.CreatedAt.Unix(),
+		ExpiresAtUnix: s.ExpiresAt.Unix(),
+		Suppressed:    s.Suppressed,
+	}
+}
+
+// CreateSilenceRequestToSilence converts a CreateSilence request to a silence and its duration
+func CreateSilenceRequestToSilence(req *pb.CreateSilenceRequest) (alert.Silence, time.Duration) {
+	s := alert.Silence{
+		Container: req.GetContainer(),
+		Labels:    req.GetLabels(),
+		Comment:   req.GetComment(),
+	}
+	for _, t := range req.GetEventTypes() {
+		s.EventTypes = append(s.EventTypes, event.EventType(t))
+	}
+	return s, time.Duration(req.GetDurationSeconds()) * time.Second
+}
+
+// SuppressionToProto converts a suppressed event record to proto
+func SuppressionToProto(s alert.Suppression) *pb.SuppressedEvent {
+	return &pb.SuppressedEvent{
+		Event:            EventToProto(s.Event),
+		Reason:           s.Reason,
+		SilenceId:        s.SilenceID,
+		SuppressedAtUnix: s.Time.Unix(),
 	}
 }
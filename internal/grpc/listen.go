@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// listenUnix listens on a Unix socket with the given permissions and group.
+// A socket left behind by a daemon that did not shut down cleanly is replaced;
+// a socket another process still serves is an error.
+func listenUnix(path string, mode os.FileMode, group string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("create socket directory: %w", err)
+	}
+	if fi, err := os.Lstat(path); err == nil {
+		if fi.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and is not a socket", path)
+		}
+		if conn, err := net.DialTimeout("unix", path, time.Second); err == nil {
+			conn.Close()
+			return nil, fmt.Errorf("%s is in use by another process", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("remove stale socket: %w", err)
+		}
+	}
+
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("listen %s: %w", path, err)
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		lis.Close()
+		return nil, fmt.Errorf("set socket permissions: %w", err)
+	}
+	if group != "" {
+		gid, err := lookupGID(group)
+		if err != nil {
+			lis.Close()
+			return nil, err
+		}
+		if err := os.Chown(path, -1, gid); err != nil {
+			lis.Close()
+			return nil, fmt.Errorf("set socket group: %w", err)
+		}
+	}
+	return lis, nil
+}
+
+// lookupGID resolves a group name or numeric GID
+func lookupGID(group string) (int, error) {
+	g, err := user.LookupGroup(group)
+	if err != nil {
+		if g, err = user.LookupGroupId(group); err != nil {
+			return 0, fmt.Errorf("unknown socket group %q", group)
+		}
+	}
+	gid, err := strconv.Atoi(g.Gid)
+	if err != nil {
+		return 0, fmt.Errorf("invalid gid of group %q: %w", group, err)
+	}
+	return gid, nil
+}
+
+// IsLocalAddress reports whether address only reaches this host: a Unix socket
+// ("unix:" target) or a loopback TCP address such as "127.0.0.1:50051" or "localhost:50051".
+// An empty host (e.g. ":50051") listens on all interfaces and is not local.
+func IsLocalAddress(address string) bool {
+	if strings.HasPrefix(address, "unix:") {
+		return true
+	}
+	// Client targets may carry a resolver scheme, e.g. "dns:///localhost:50051"
+	if _, rest, ok := strings.Cut(address, ":///"); ok {
+		address = rest
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
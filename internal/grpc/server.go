@@ -2,14 +2,25 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"os"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	pb "docksphinx/api/docksphinx/v1"
+	"docksphinx/internal/alert"
+	"docksphinx/internal/docker"
+	"docksphinx/internal/event"
 	"docksphinx/internal/monitor"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 )
@@ -17,53 +28,218 @@ import (
 // Server is the gRPC server for DocksphinxService
 type Server struct {
 	pb.UnimplementedDocksphinxServiceServer
-	lis    net.Listener
-	grpc   *grpc.Server
-	opts   *ServerOptions
-	engine *monitor.Engine
-	bcast  *Broadcaster
-	mu     sync.Mutex
+	listeners []listener
+	stopped   bool
+	opts      *ServerOptions
+	engine    *monitor.Engine
+	bcast     *Broadcaster
+	health    *health.Server
+	started   time.Time
+	done      chan struct{} // Closed by Stop to end the health loop
+	mu        sync.Mutex
+}
+
+// listener is a listener with the gRPC server serving it. The Unix socket and
+// the TCP address have separate servers, since only TCP may use TLS credentials.
+type listener struct {
+	lis  net.Listener
+	grpc *grpc.Server
 }
 
 // ServerOptions configures the gRPC server
 type ServerOptions struct {
-	Address string // e.g. "127.0.0.1:50051"
+	Socket      string         // Unix socket path (empty: no socket)
+	SocketMode  os.FileMode    // Permissions of the socket file (default: 0600)
+	SocketGroup string         // Group owning the socket, name or GID (optional)
+	Address     string         // TCP address, e.g. "127.0.0.1:50051" (empty: no TCP listener)
+	TLS         *TLSOptions    // TLS of the TCP listener (nil: plaintext)
+	Auth        *AuthOptions   // Bearer-token authentication (nil: every client has full access)
+	AuditLog    string         // JSON lines file recording admin calls (empty: not recorded)
+	Alerts      *alert.Manager // Serves the silence RPCs (optional)
+	Version     string         // Reported by GetDaemonStatus
+	ConfigPath  string         // Reported by GetDaemonStatus (empty: built-in defaults)
 }
 
-// NewServer creates a new gRPC server (does not start listening).
+// NewServer creates a new gRPC server listening on the socket and/or TCP address (does not serve yet).
 // Engine must already be started; its event channel is fanned out via Broadcaster.
 func NewServer(opts *ServerOptions, engine *monitor.Engine) (*Server, error) {
 	if opts == nil {
 		opts = &ServerOptions{Address: "127.0.0.1:50051"}
 	}
-	lis, err := net.Listen("tcp", opts.Address)
-	if err != nil {
-		return nil, fmt.Errorf("listen %s: %w", opts.Address, err)
+	if opts.Socket == "" && opts.Address == "" {
+		return nil, fmt.Errorf("no socket or address to listen on")
+	}
+	var serverOpts []grpc.ServerOption
+	if opts.Auth != nil || opts.AuditLog != "" {
+		auth, err := newAuthenticator(opts.Auth, opts.AuditLog)
+		if err != nil {
+			return nil, err
+		}
+		serverOpts = append(serverOpts,
+			grpc.ChainUnaryInterceptor(auth.unaryInterceptor),
+			grpc.ChainStreamInterceptor(auth.streamInterceptor))
+	}
+	var listeners []listener
+	closeAll := func() {
+		for _, l := range listeners {
+			l.lis.Close()
+		}
+	}
+	if opts.Socket != "" {
+		mode := opts.SocketMode
+		if mode == 0 {
+			mode = 0o600
+		}
+		lis, err := listenUnix(opts.Socket, mode, opts.SocketGroup)
+		if err != nil {
+			return nil, err
+		}
+		listeners = append(listeners, listener{lis: lis, grpc: grpc.NewServer(serverOpts...)})
+	}
+	if opts.Address != "" {
+		tcpOpts := serverOpts
+		if opts.TLS != nil {
+			cfg, err := ServerTLSConfig(*opts.TLS)
+			if err != nil {
+				closeAll()
+				return nil, err
+			}
+			// Handshakes run under gRPC's connection timeout and the peer's TLS state reaches handlers
+			tcpOpts = append(append([]grpc.ServerOption{}, serverOpts...), grpc.Creds(credentials.NewTLS(cfg)))
+		}
+		lis, err := net.Listen("tcp", opts.Address)
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("listen %s: %w", opts.Address, err)
+		}
+		listeners = append(listeners, listener{lis: lis, grpc: grpc.NewServer(tcpOpts...)})
 	}
-	s := grpc.NewServer()
 	bcast := NewBroadcaster()
-	srv := &Server{lis: lis, grpc: s, opts: opts, engine: engine, bcast: bcast}
-	pb.RegisterDocksphinxServiceServer(s, srv)
-	reflection.Register(s)
+	srv := &Server{
+		listeners: listeners,
+		opts:      opts,
+		engine:    engine,
+		bcast:     bcast,
+		health:    health.NewServer(),
+		started:   time.Now(),
+		done:      make(chan struct{}),
+	}
+	for _, l := range listeners {
+		pb.RegisterDocksphinxServiceServer(l.grpc, srv)
+		healthpb.RegisterHealthServer(l.grpc, srv.health)
+		reflection.Register(l.grpc)
+	}
 	go bcast.Run(engine.GetEventChannel())
+	go srv.healthLoop()
 	return srv, nil
 }
 
-// Start starts the gRPC server (blocking). Call from a goroutine.
+// healthCheckInterval is how often the health service is updated from the engine state
+const healthCheckInterval = 5 * time.Second
+
+// healthLoop reports the engine's health through the grpc.health.v1 service until Stop
+func (s *Server) healthLoop() {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		st := healthpb.HealthCheckResponse_SERVING
+		if s.engine.CheckHealth() != nil {
+			st = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		// The empty service name reports the server as a whole
+		s.health.SetServingStatus("", st)
+		s.health.SetServingStatus(pb.DocksphinxService_ServiceDesc.ServiceName, st)
+
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Start serves all listeners (blocking) and returns the first error. Call from a goroutine.
 func (s *Server) Start() error {
-	return s.grpc.Serve(s.lis)
+	errs := make(chan error, len(s.listeners))
+	for _, l := range s.listeners {
+		go func() { errs <- l.grpc.Serve(l.lis) }()
+	}
+	for range s.listeners {
+		if err := <-errs; err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// Stop gracefully stops the gRPC server
+// Addrs returns the addresses the server listens on, e.g. "unix:///run/user/1000/docksphinx.sock"
+func (s *Server) Addrs() []string {
+	addrs := make([]string, 0, len(s.listeners))
+	for _, l := range s.listeners {
+		if l.lis.Addr().Network() == "unix" {
+			addrs = append(addrs, "unix://"+l.lis.Addr().String())
+		} else {
+			addrs = append(addrs, l.lis.Addr().String())
+		}
+	}
+	return addrs
+}
+
+// stopTimeout bounds graceful shutdown; Stream RPCs only end when clients disconnect
+const stopTimeout = 5 * time.Second
+
+// Stop gracefully stops the gRPC servers, forcing them closed after stopTimeout
 func (s *Server) Stop() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if s.grpc != nil {
-		s.grpc.GracefulStop()
-		s.grpc = nil
+	if s.stopped {
+		return
+	}
+	s.stopped = true
+	close(s.done)
+	// Tells health watchers the server is going away before connections are closed
+	s.health.Shutdown()
+
+	done := make(chan struct{})
+	go func() {
+		var wg sync.WaitGroup
+		for _, l := range s.listeners {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				l.grpc.GracefulStop()
+			}()
+		}
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(stopTimeout):
+		for _, l := range s.listeners {
+			l.grpc.Stop()
+		}
+		<-done
 	}
 }
 
+// Subscribe subscribes to engine events, like the Stream RPC does.
+// Call the returned function when done receiving.
+func (s *Server) Subscribe() (<-chan *event.Event, func()) {
+	return s.bcast.Subscribe()
+}
+
+// SubscriberCount returns the number of connected Stream subscribers
+func (s *Server) SubscriberCount() int {
+	return s.bcast.SubscriberCount()
+}
+
+// DroppedEvents returns the number of events not delivered to slow Stream subscribers
+func (s *Server) DroppedEvents() uint64 {
+	return s.bcast.Dropped()
+}
+
 // GetSnapshot implements DocksphinxService
 func (s *Server) GetSnapshot(ctx context.Context, req *pb.GetSnapshotRequest) (*pb.Snapshot, error) {
 	sm := s.engine.GetStateManager()
@@ -73,6 +249,289 @@ func (s *Server) GetSnapshot(ctx context.Context, req *pb.GetSnapshotRequest) (*
 	return StateToSnapshot(sm), nil
 }
 
+// ListImages implements DocksphinxService
+func (s *Server) ListImages(ctx context.Context, req *pb.ListImagesRequest) (*pb.ListImagesResponse, error) {
+	sm := s.engine.GetStateManager()
+	if sm == nil {
+		return nil, status.Error(codes.Unavailable, "state not available")
+	}
+	inventory := sm.GetInventory()
+	return &pb.ListImagesResponse{
+		Images:          ImagesToProto(inventory.Images),
+		CollectedAtUnix: collectedAtUnix(inventory.CollectedAt),
+	}, nil
+}
+
+// ListNetworks implements DocksphinxService
+func (s *Server) ListNetworks(ctx context.Context, req *pb.ListNetworksRequest) (*pb.ListNetworksResponse, error) {
+	sm := s.engine.GetStateManager()
+	if sm == nil {
+		return nil, status.Error(codes.Unavailable, "state not available")
+	}
+	inventory := sm.GetInventory()
+	return &pb.ListNetworksResponse{
+		Networks:        NetworksToProto(inventory.Networks),
+		CollectedAtUnix: collectedAtUnix(inventory.CollectedAt),
+	}, nil
+}
+
+// ListVolumes implements DocksphinxService
+func (s *Server) ListVolumes(ctx context.Context, req *pb.ListVolumesRequest) (*pb.ListVolumesResponse, error) {
+	sm := s.engine.GetStateManager()
+	if sm == nil {
+		return nil, status.Error(codes.Unavailable, "state not available")
+	}
+	inventory := sm.GetInventory()
+	du, _ := sm.GetDiskUsage()
+	return &pb.ListVolumesResponse{
+		Volumes:         VolumesToProto(inventory.Volumes, du),
+		CollectedAtUnix: collectedAtUnix(inventory.CollectedAt),
+	}, nil
+}
+
+// GetDependencyGraph implements DocksphinxService
+func (s *Server) GetDependencyGraph(ctx context.Context, req *pb.GetDependencyGraphRequest) (*pb.DependencyGraph, error) {
+	sm := s.engine.GetStateManager()
+	if sm == nil {
+		return nil, status.Error(codes.Unavailable, "state not available")
+	}
+	g, collectedAt := sm.GetDependencyGraph()
+	if g == nil {
+		return nil, status.Error(codes.Unavailable, "dependency graph not collected yet")
+	}
+	if req.GetContainerId() != "" {
+		if _, ok := g.Node(req.GetContainerId()); !ok {
+			return nil, status.Errorf(codes.NotFound, "container %s not found in dependency graph", req.GetContainerId())
+		}
+		g = g.Subgraph(req.GetContainerId())
+	}
+
+	resp := GraphToProto(g, collectedAt)
+	switch req.GetFormat() {
+	case "":
+	case "dot":
+		resp.Rendered = g.DOT()
+	case "json":
+		data, err := g.JSON()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "render graph: %v", err)
+		}
+		resp.Rendered = string(data)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown graph format %q (want dot or json)", req.GetFormat())
+	}
+	return resp, nil
+}
+
+// GetLogs implements DocksphinxService
+func (s *Server) GetLogs(ctx context.Context, req *pb.GetLogsRequest) (*pb.GetLogsResponse, error) {
+	id, err := s.trackedContainer(req.GetContainerId())
+	if err != nil {
+		return nil, err
+	}
+	client := s.engine.GetDockerClient()
+	if client == nil {
+		return nil, status.Error(codes.Unavailable, "docker client not available")
+	}
+	lines, err := client.GetContainerLogs(ctx, id, LogsRequestToOptions(req))
+	if err != nil {
+		return nil, dockerErrorToStatus(err)
+	}
+	resp := &pb.GetLogsResponse{Lines: make([]*pb.LogLine, 0, len(lines))}
+	for _, line := range lines {
+		resp.Lines = append(resp.Lines, LogLineToProto(line))
+	}
+	return resp, nil
+}
+
+// FollowLogs implements DocksphinxService
+func (s *Server) FollowLogs(req *pb.GetLogsRequest, stream pb.DocksphinxService_FollowLogsServer) error {
+	id, err := s.trackedContainer(req.GetContainerId())
+	if err != nil {
+		return err
+	}
+	client := s.engine.GetDockerClient()
+	if client == nil {
+		return status.Error(codes.Unavailable, "docker client not available")
+	}
+	opts := LogsRequestToOptions(req)
+	opts.Until = time.Time{}
+	err = client.FollowContainerLogs(stream.Context(), id, opts, func(line docker.LogLine) error {
+		return stream.Send(LogLineToProto(line))
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return dockerErrorToStatus(err)
+	}
+	return nil
+}
+
+// trackedContainer resolves a container ID, unique ID prefix or name to the ID of a monitored container.
+// The log RPCs are limited to these, so clients cannot read containers outside the monitor filters.
+func (s *Server) trackedContainer(ref string) (string, error) {
+	if ref == "" {
+		return "", status.Error(codes.InvalidArgument, "container_id is required")
+	}
+	sm := s.engine.GetStateManager()
+	if sm == nil {
+		return "", status.Error(codes.Unavailable, "state not available")
+	}
+	var matches []string
+	for _, st := range sm.GetAllStates() {
+		if st.ContainerID == ref || st.ContainerName == ref {
+			return st.ContainerID, nil
+		}
+		if strings.HasPrefix(st.ContainerID, ref) {
+			matches = append(matches, st.ContainerID)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", status.Errorf(codes.NotFound, "container %s is not monitored", ref)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "container ID prefix %s is ambiguous", ref)
+	}
+}
+
+// GetEventHistory implements DocksphinxService
+func (s *Server) GetEventHistory(ctx context.Context, req *pb.GetEventHistoryRequest) (*pb.GetEventHistoryResponse, error) {
+	history := s.engine.GetEventHistory()
+	if history == nil {
+		return nil, status.Error(codes.Unavailable, "event history not available")
+	}
+	q := monitor.EventQuery{
+		ContainerID: req.GetContainerId(),
+		MinSeverity: SeverityFromProto(req.GetMinSeverity()),
+		Limit:       int(req.GetLimit()),
+	}
+	for _, t := range req.GetTypes() {
+		q.Types = append(q.Types, event.EventType(t))
+	}
+	if req.GetSinceUnix() > 0 {
+		q.Since = time.Unix(req.GetSinceUnix(), 0)
+	}
+	events := history.Query(q)
+	resp := &pb.GetEventHistoryResponse{Events: make([]*pb.Event, 0, len(events))}
+	for _, ev := range events {
+		resp.Events = append(resp.Events, EventToProto(ev))
+	}
+	return resp, nil
+}
+
+// GetMetricHistory implements DocksphinxService
+func (s *Server) GetMetricHistory(ctx context.Context, req *pb.GetMetricHistoryRequest) (*pb.GetMetricHistoryResponse, error) {
+	sm := s.engine.GetStateManager()
+	if sm == nil {
+		return nil, status.Error(codes.Unavailable, "state not available")
+	}
+	id := req.GetContainerId()
+	resp := &pb.GetMetricHistoryResponse{}
+	for _, state := range sm.GetAllStates() {
+		if id != "" && state.ContainerID != id && state.ContainerName != id {
+			continue
+		}
+		resp.Histories = append(resp.Histories, MetricHistoryToProto(state))
+	}
+	if id != "" && len(resp.Histories) == 0 {
+		return nil, status.Errorf(codes.NotFound, "container %s not found", id)
+	}
+	sort.Slice(resp.Histories, func(i, j int) bool {
+		return resp.Histories[i].GetContainerName() < resp.Histories[j].GetContainerName()
+	})
+	return resp, nil
+}
+
+// QueryMetrics implements DocksphinxService
+func (s *Server) QueryMetrics(ctx context.Context, req *pb.QueryMetricsRequest) (*pb.QueryMetricsResponse, error) {
+	store := s.engine.GetMetricStore()
+	if store == nil {
+		return nil, status.Error(codes.FailedPrecondition, "metric storage is disabled")
+	}
+	q, err := QueryMetricsRequestToQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	result, err := store.Query(q)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return QueryResultToProto(result), nil
+}
+
+// CreateSilence implements DocksphinxService
+func (s *Server) CreateSilence(ctx context.Context, req *pb.CreateSilenceRequest) (*pb.Silence, error) {
+	if s.opts.Alerts == nil {
+		return nil, status.Error(codes.FailedPrecondition, "alerting is not enabled")
+	}
+	silence, d := CreateSilenceRequestToSilence(req)
+	created, err := s.opts.Alerts.CreateSilence(silence, d)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return SilenceToProto(created), nil
+}
+
+// ListSilences implements DocksphinxService
+func (s *Server) ListSilences(ctx context.Context, req *pb.ListSilencesRequest) (*pb.ListSilencesResponse, error) {
+	if s.opts.Alerts == nil {
+		return nil, status.Error(codes.FailedPrecondition, "alerting is not enabled")
+	}
+	resp := &pb.ListSilencesResponse{}
+	for _, silence := range s.opts.Alerts.ListSilences(req.GetIncludeExpired()) {
+		resp.Silences = append(resp.Silences, SilenceToProto(silence))
+	}
+	if req.GetIncludeSuppressed() {
+		for _, sup := range s.opts.Alerts.Suppressed() {
+			resp.Suppressed = append(resp.Suppressed, SuppressionToProto(sup))
+		}
+	}
+	return resp, nil
+}
+
+// GetDaemonStatus implements DocksphinxService
+func (s *Server) GetDaemonStatus(ctx context.Context, req *pb.GetDaemonStatusRequest) (*pb.DaemonStatus, error) {
+	stats := s.engine.GetStats()
+	resp := &pb.DaemonStatus{
+		Version:                       s.opts.Version,
+		StartedAtUnix:                 s.started.Unix(),
+		UptimeSeconds:                 int64(time.Since(s.started).Seconds()),
+		ConfigPath:                    s.opts.ConfigPath,
+		Healthy:                       true,
+		LastCollectionUnix:            collectedAtUnix(stats.LastCollectAt),
+		LastCollectionDurationSeconds: stats.LastCollectDuration.Seconds(),
+		TrackedContainers:             int32(stats.MonitoredContainers),
+		Subscribers:                   int32(s.bcast.SubscriberCount()),
+		DroppedEvents:                 stats.DroppedEvents,
+		DroppedSubscriberEvents:       s.bcast.Dropped(),
+	}
+	if stats.LastCollectError != nil {
+		resp.LastCollectionError = stats.LastCollectError.Error()
+	}
+	if err := s.engine.CheckHealth(); err != nil {
+		resp.Healthy = false
+		resp.HealthMessage = err.Error()
+	}
+	return resp, nil
+}
+
+// dockerErrorToStatus maps docker package errors to gRPC status errors
+func dockerErrorToStatus(err error) error {
+	switch {
+	case docker.IsNotFoundError(err):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, docker.ErrDockerNotRunning):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, docker.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
 // Stream implements DocksphinxService
 func (s *Server) Stream(req *pb.StreamRequest, stream pb.DocksphinxService_StreamServer) error {
 	if req != nil && req.IncludeInitialSnapshot {
@@ -83,14 +542,35 @@ func (s *Server) Stream(req *pb.StreamRequest, stream pb.DocksphinxService_Strea
 	}
 	sub, unsub := s.bcast.Subscribe()
 	defer unsub()
+	minSeverity := SeverityFromProto(req.GetMinSeverity())
+
+	// Optional periodic snapshots so clients see metric updates without polling
+	var snapshotTick <-chan time.Time
+	if interval := req.GetSnapshotIntervalSeconds(); interval > 0 {
+		ticker := time.NewTicker(time.Duration(interval) * time.Second)
+		defer ticker.Stop()
+		snapshotTick = ticker.C
+	}
+
 	for {
 		select {
 		case <-stream.Context().Done():
 			return nil
+		case <-snapshotTick:
+			sm := s.engine.GetStateManager()
+			if sm == nil {
+				continue
+			}
+			if err := stream.Send(&pb.StreamUpdate{Payload: &pb.StreamUpdate_Snapshot{Snapshot: StateToSnapshot(sm)}}); err != nil {
+				return err
+			}
 		case ev, ok := <-sub:
 			if !ok {
 				return nil
 			}
+			if ev.Severity < minSeverity {
+				continue
+			}
 			if err := stream.Send(&pb.StreamUpdate{Payload: &pb.StreamUpdate_Event{Event: EventToProto(ev)}}); err != nil {
 				return err
 			}
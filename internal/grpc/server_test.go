@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"testing"
+
+	"docksphinx/internal/monitor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTrackedContainer(t *testing.T) {
+	engine, err := monitor.NewEngine(monitor.EngineConfig{}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create engine: %v", err)
+	}
+	sm := engine.GetStateManager()
+	sm.UpdateState("abc123", &monitor.ContainerState{ContainerID: "abc123", ContainerName: "web"})
+	sm.UpdateState("abd456", &monitor.ContainerState{ContainerID: "abd456", ContainerName: "db"})
+	s := &Server{engine: engine}
+
+	for ref, want := range map[string]string{"abc123": "abc123", "web": "abc123", "abd": "abd456"} {
+		if id, err := s.trackedContainer(ref); err != nil || id != want {
+			t.Errorf("trackedContainer(%q) = %q, %v; want %q", ref, id, err, want)
+		}
+	}
+	for ref, want := range map[string]codes.Code{"": codes.InvalidArgument, "ab": codes.InvalidArgument, "unmonitored": codes.NotFound} {
+		if _, err := s.trackedContainer(ref); status.Code(err) != want {
+			t.Errorf("trackedContainer(%q): expected %s, got %v", ref, want, err)
+		}
+	}
+}
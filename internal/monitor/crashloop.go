@@ -0,0 +1,50 @@
+package monitor
+
+import (
+	"fmt"
+	"time"
+
+	"docksphinx/internal/event"
+)
+
+const (
+	// DefaultCrashLoopCount is the number of crashes within DefaultCrashLoopWindow reported as a crash loop
+	DefaultCrashLoopCount = 3
+	// DefaultCrashLoopWindow is the window in which crashes of a container are counted
+	DefaultCrashLoopWindow = 10 * time.Minute
+)
+
+// RecordCrash records a crash (non-zero exit, death or restart by the restart policy) of a container.
+// Returns a crash_loop event when the container crashed DefaultCrashLoopCount times within
+// DefaultCrashLoopWindow; the count then starts over, so a loop is reported once per window at most.
+func (d *Detector) RecordCrash(containerID, containerName, imageName string, exitCode int, at time.Time) *event.Event {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var recent []time.Time
+	for _, t := range d.crashes[containerID] {
+		if at.Sub(t) < DefaultCrashLoopWindow {
+			recent = append(recent, t)
+		}
+	}
+	recent = append(recent, at)
+	if len(recent) < DefaultCrashLoopCount {
+		d.crashes[containerID] = recent
+		return nil
+	}
+	delete(d.crashes, containerID)
+
+	evt := event.NewEvent(event.EventTypeCrashLoop, containerID, containerName, imageName)
+	evt.Timestamp = at
+	evt.Message = fmt.Sprintf("Container %s crashed %d times within %s", containerName, len(recent), DefaultCrashLoopWindow)
+	evt.Severity = event.SeverityCritical
+	evt.SetPayload(&event.CrashLoopPayload{Crashes: len(recent), Window: DefaultCrashLoopWindow, ExitCode: exitCode})
+	return evt
+}
+
+// forgetCrashes drops the crash history of a removed container
+func (d *Detector) forgetCrashes(containerID string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.crashes, containerID)
+}
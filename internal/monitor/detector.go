@@ -2,6 +2,7 @@ package monitor
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"docksphinx/internal/event"
@@ -10,12 +11,27 @@ import (
 // Detector detects container state changes and generates events
 type Detector struct {
 	stateManager *StateManager
+
+	// Impact correlation (see impact.go)
+	mu           sync.Mutex
+	impactWindow time.Duration
+	failures     map[string]failure      // Key: ContainerID, recent died/stopped
+	impacts      map[string]*impactGroup // Key: root cause ContainerID
+	affectedBy   map[string]string       // Key: affected ContainerID, value: root cause ContainerID
+
+	// Crash loop detection (see crashloop.go)
+	crashes map[string][]time.Time // Key: ContainerID, recent crash times
 }
 
 // NewDetector creates a new event detector
 func NewDetector(stateManager *StateManager) *Detector {
 	return &Detector{
 		stateManager: stateManager,
+		impactWindow: DefaultImpactWindow,
+		failures:     make(map[string]failure),
+		impacts:      make(map[string]*impactGroup),
+		affectedBy:   make(map[string]string),
+		crashes:      make(map[string][]time.Time),
 	}
 }
 
@@ -31,6 +47,7 @@ func (d *Detector) DetectStateChange(containerID, containerName, imageName, curr
 		if currentState == "running" {
 			evt := event.NewEvent(event.EventTypeStarted, containerID, containerName, imageName)
 			evt.Message = fmt.Sprintf("Container %s started", containerName)
+			evt.Severity = event.SeverityInfo
 			events = append(events, evt)
 		}
 		return events
@@ -48,21 +65,23 @@ func (d *Detector) DetectStateChange(containerID, containerName, imageName, curr
 					// Likely a restart
 					evt := event.NewEvent(event.EventTypeRestarted, containerID, containerName, imageName)
 					evt.Message = fmt.Sprintf("Container %s restarted", containerName)
-					evt.Data["previous_state"] = oldState.State
-					evt.Data["time_since_stop"] = timeSinceLastSeen.Seconds()
+					evt.Severity = event.SeverityWarning
+					evt.SetPayload(&event.StateChangePayload{PreviousState: oldState.State, TimeSinceStop: timeSinceLastSeen})
 					events = append(events, evt)
 				} else {
 					// New start after a long time
 					evt := event.NewEvent(event.EventTypeStarted, containerID, containerName, imageName)
 					evt.Message = fmt.Sprintf("Container %s started", containerName)
-					evt.Data["previous_state"] = oldState.State
+					evt.Severity = event.SeverityInfo
+					evt.SetPayload(&event.StateChangePayload{PreviousState: oldState.State})
 					events = append(events, evt)
 				}
 			} else {
 				// Transition from other state to running
 				evt := event.NewEvent(event.EventTypeStarted, containerID, containerName, imageName)
 				evt.Message = fmt.Sprintf("Container %s started", containerName)
-				evt.Data["previous_state"] = oldState.State
+				evt.Severity = event.SeverityInfo
+				evt.SetPayload(&event.StateChangePayload{PreviousState: oldState.State})
 				events = append(events, evt)
 			}
 
@@ -70,17 +89,36 @@ func (d *Detector) DetectStateChange(containerID, containerName, imageName, curr
 			// Container stopped normally
 			evt := event.NewEvent(event.EventTypeStopped, containerID, containerName, imageName)
 			evt.Message = fmt.Sprintf("Container %s stopped", containerName)
-			evt.Data["previous_state"] = oldState.State
+			evt.Severity = event.SeverityInfo
+			evt.SetPayload(&event.StateChangePayload{PreviousState: oldState.State})
+			d.handleFailure(evt)
 			events = append(events, evt)
 
 		case "dead":
 			// Container died (abnormal exit)
 			evt := event.NewEvent(event.EventTypeDied, containerID, containerName, imageName)
 			evt.Message = fmt.Sprintf("Container %s died (abnormal exit)", containerName)
-			evt.Data["previous_state"] = oldState.State
+			evt.Severity = event.SeverityCritical
+			evt.SetPayload(&event.StateChangePayload{PreviousState: oldState.State})
+			d.handleFailure(evt)
 			events = append(events, evt)
 		}
 	}
 
 	return events
 }
+
+// handleFailure annotates a died/stopped event with dependents and records it for impact correlation
+func (d *Detector) handleFailure(evt *event.Event) {
+	g, _ := d.stateManager.GetDependencyGraph()
+	annotateDependents(evt, g)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.recordFailure(failure{
+		containerID:   evt.ContainerID,
+		containerName: evt.ContainerName,
+		imageName:     evt.ImageName,
+		at:            evt.Timestamp,
+	}, g)
+}
@@ -4,15 +4,27 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"docksphinx/internal/docker"
 	"docksphinx/internal/event"
+	"docksphinx/internal/graph"
+	"docksphinx/internal/tsdb"
+)
+
+const (
+	// DefaultResourceInterval is the default collection interval for images, networks and volumes
+	DefaultResourceInterval = 60 * time.Second
+	// DefaultDiskUsageInterval is the default collection interval for disk usage
+	DefaultDiskUsageInterval = 5 * time.Minute
 )
 
 // EngineConfig represents monitoring engine configuration
 type EngineConfig struct {
-	Interval time.Duration // Collection interval
+	Interval          time.Duration // Collection interval
+	ResourceInterval  time.Duration // Collection interval for images, networks, volumes and the dependency graph (slower than Interval)
+	DiskUsageInterval time.Duration // Collection interval for disk usage (expensive, slowest)
 
 	// Filters
 	ContainerNamePattern string // Regex pattern for container names
@@ -20,6 +32,41 @@ type EngineConfig struct {
 
 	// Thresholds
 	Thresholds ThresholdConfig
+
+	// Failures of dependents within this window after a dependency failed are
+	// reported as a single impact event
+	ImpactWindow time.Duration
+
+	// Log pattern detection (disabled unless a container pattern and patterns are set)
+	LogWatch LogWatchConfig
+
+	// Log capture for failure events
+	LogCapture LogCaptureConfig
+
+	// Number of events kept in memory for the event history API
+	EventHistorySize int
+
+	// Number of metric samples kept per container for the metric history API
+	MetricHistorySize int
+
+	// Persistent metric storage (disabled if MetricStore.Dir is empty)
+	MetricStore tsdb.Config
+}
+
+// DefaultLogCaptureLines is the default number of log lines captured with failure events
+const DefaultLogCaptureLines = 50
+
+// DefaultLogCaptureEventTypes returns the event types logs are captured for by default
+func DefaultLogCaptureEventTypes() []event.EventType {
+	return []event.EventType{event.EventTypeDied, event.EventTypeOOMKilled, event.EventTypeCrashLoop}
+}
+
+// LogCaptureConfig represents configuration for capturing logs with events
+type LogCaptureConfig struct {
+	Lines int // Number of last lines to capture (0: DefaultLogCaptureLines, negative: disabled)
+	// Event types to capture logs for (default: DefaultLogCaptureEventTypes).
+	// With died, stopped events with a non-zero exit code are captured too.
+	EventTypes []event.EventType
 }
 
 // Engine is the main monitoring engine
@@ -29,10 +76,19 @@ type Engine struct {
 	stateManager *StateManager
 	detector     *Detector
 	thresholdMon *ThresholdMonitor
+	logWatcher   *LogWatcher // nil if log watching is disabled
+	history      *EventHistory
+	metricStore  *tsdb.Store // nil if metric storage is disabled
+	stats        *statsRecorder
+	captureTypes map[event.EventType]bool
 
 	// Event channel for publishing events
 	eventChan chan *event.Event
 
+	// Docker daemon outage (see reconnect.go); dockerDown is also read by the resource loops
+	conn       dockerConn
+	dockerDown atomic.Bool
+
 	// Control
 	ctx     context.Context
 	cancel  context.CancelFunc
@@ -45,21 +101,62 @@ type Engine struct {
 func NewEngine(config EngineConfig, dockerClient *docker.Client) (*Engine, error) {
 	stateManager := NewStateManager()
 	detector := NewDetector(stateManager)
+	if config.ImpactWindow > 0 {
+		detector.impactWindow = config.ImpactWindow
+	}
 	thresholdMon := NewThresholdMonitor(config.Thresholds)
 
+	if config.ResourceInterval <= 0 {
+		config.ResourceInterval = DefaultResourceInterval
+	}
+	if config.DiskUsageInterval <= 0 {
+		config.DiskUsageInterval = DefaultDiskUsageInterval
+	}
+	if config.LogCapture.Lines == 0 {
+		config.LogCapture.Lines = DefaultLogCaptureLines
+	}
+	if len(config.LogCapture.EventTypes) == 0 {
+		config.LogCapture.EventTypes = DefaultLogCaptureEventTypes()
+	}
+	captureTypes := make(map[event.EventType]bool)
+	for _, t := range config.LogCapture.EventTypes {
+		captureTypes[t] = true
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 
-	return &Engine{
+	e := &Engine{
 		config:       config,
 		dockerClient: dockerClient,
 		stateManager: stateManager,
 		detector:     detector,
 		thresholdMon: thresholdMon,
+		history:      NewEventHistory(config.EventHistorySize),
+		captureTypes: captureTypes,
+		stats:        newStatsRecorder(),
 		eventChan:    make(chan *event.Event, 100),
 		ctx:          ctx,
 		cancel:       cancel,
 		running:      false,
-	}, nil
+	}
+
+	logWatcher, err := NewLogWatcher(config.LogWatch, dockerClient, e.publish, &e.wg)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	e.logWatcher = logWatcher
+
+	if config.MetricStore.Dir != "" {
+		store, err := tsdb.Open(config.MetricStore)
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("open metric store: %w", err)
+		}
+		e.metricStore = store
+	}
+
+	return e, nil
 }
 
 // Start starts the monitoring engine
@@ -72,8 +169,10 @@ func (e *Engine) Start() error {
 	}
 
 	e.running = true
-	e.wg.Add(1)
+	e.wg.Add(3)
 	go e.monitorLoop()
+	go e.resourceLoop()
+	go e.diskUsageLoop()
 
 	return nil
 }
@@ -91,6 +190,12 @@ func (e *Engine) Stop() {
 	e.cancel()
 	e.wg.Wait()
 	close(e.eventChan)
+
+	if e.metricStore != nil {
+		if err := e.metricStore.Close(); err != nil {
+			fmt.Printf("Error closing metric store: %v\n", err)
+		}
+	}
 }
 
 // monitorLoop is the main monitoring loop
@@ -112,6 +217,236 @@ func (e *Engine) monitorLoop() {
 	}
 }
 
+// resourceLoop periodically collects images, networks, volumes and the dependency graph.
+// These change rarely, so they are collected at ResourceInterval instead of Interval.
+func (e *Engine) resourceLoop() {
+	defer e.wg.Done()
+
+	ticker := time.NewTicker(e.config.ResourceInterval)
+	defer ticker.Stop()
+
+	e.collectResources()
+
+	for {
+		select {
+		case <-e.ctx.Done():
+			return
+		case <-ticker.C:
+			e.collectResources()
+		}
+	}
+}
+
+// collectResources collects the image, network and volume inventory and rebuilds the dependency graph.
+// The previous inventory is kept if any of the listings fails.
+func (e *Engine) collectResources() {
+	if e.dockerDown.Load() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(e.ctx, 30*time.Second)
+	defer cancel()
+
+	// Only monitored containers, so impact is never attributed to containers outside the filters
+	depGraph, err := graph.Collect(ctx, e.dockerClient, docker.ListContainersOptions{
+		NamePattern:  e.config.ContainerNamePattern,
+		ImagePattern: e.config.ImageNamePattern,
+	})
+	if err != nil {
+		fmt.Printf("Error building dependency graph: %v\n", err)
+	} else {
+		e.stateManager.UpdateDependencyGraph(depGraph, time.Now())
+	}
+
+	images, err := e.dockerClient.ListImages(ctx)
+	if err != nil {
+		fmt.Printf("Error listing images: %v\n", err)
+		return
+	}
+
+	networks, err := e.dockerClient.ListNetworks(ctx)
+	if err != nil {
+		fmt.Printf("Error listing networks: %v\n", err)
+		return
+	}
+
+	volumes, err := e.dockerClient.ListVolumes(ctx)
+	if err != nil {
+		fmt.Printf("Error listing volumes: %v\n", err)
+		return
+	}
+
+	e.stateManager.UpdateInventory(ResourceInventory{
+		Images:      images,
+		Networks:    networks,
+		Volumes:     volumes,
+		CollectedAt: time.Now(),
+	})
+}
+
+// diskUsageLoop periodically collects disk usage.
+// The system df API is expensive, so it runs at DiskUsageInterval.
+func (e *Engine) diskUsageLoop() {
+	defer e.wg.Done()
+
+	ticker := time.NewTicker(e.config.DiskUsageInterval)
+	defer ticker.Stop()
+
+	e.collectDiskUsage()
+
+	for {
+		select {
+		case <-e.ctx.Done():
+			return
+		case <-ticker.C:
+			e.collectDiskUsage()
+		}
+	}
+}
+
+// collectDiskUsage collects disk usage and detects volume growth
+func (e *Engine) collectDiskUsage() {
+	if e.dockerDown.Load() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(e.ctx, 2*time.Minute)
+	defer cancel()
+
+	du, err := e.dockerClient.DiskUsage(ctx)
+	if err != nil {
+		fmt.Printf("Error getting disk usage: %v\n", err)
+		return
+	}
+
+	previous, _ := e.stateManager.GetDiskUsage()
+	e.stateManager.UpdateDiskUsage(du, time.Now())
+
+	e.publish(e.thresholdMon.CheckVolumeGrowth(previous, du))
+}
+
+// publish records events in the history and sends them to the event channel without blocking.
+// Events that capture logs are delivered from a goroutine once the logs are read,
+// so that a slow Docker API does not stall the collection.
+func (e *Engine) publish(events []*event.Event) {
+	for _, evt := range events {
+		if evt.Labels == nil {
+			if st, ok := e.stateManager.GetState(evt.ContainerID); ok {
+				evt.Labels = st.Labels
+			}
+		}
+		if e.shouldCapture(evt) {
+			// Callers run under wg, so Stop waits for the capture before closing the event channel
+			e.wg.Add(1)
+			go func() {
+				defer e.wg.Done()
+				e.captureLogs(evt)
+				e.deliver(evt)
+			}()
+			continue
+		}
+		e.deliver(evt)
+	}
+}
+
+// shouldCapture reports whether logs are captured for an event.
+// A stop with a non-zero exit code is a crash, so it is captured whenever died events are.
+func (e *Engine) shouldCapture(evt *event.Event) bool {
+	if e.config.LogCapture.Lines <= 0 || evt.ContainerID == "" {
+		return false
+	}
+	if e.captureTypes[evt.Type] {
+		return true
+	}
+	p, ok := evt.Payload.(*event.StateChangePayload)
+	return ok && evt.Type == event.EventTypeStopped && p.ExitCode != 0 && e.captureTypes[event.EventTypeDied]
+}
+
+// classifyExit adds the exit code to stopped and died events, turns stops caused by the
+// OOM killer into oom_killed events and returns a crash_loop event if the container keeps crashing
+func (e *Engine) classifyExit(ctx context.Context, c docker.Container, events []*event.Event) []*event.Event {
+	// The restart policy restarts a container after it exited
+	crashed := c.State == "restarting"
+	code, _ := docker.ExitCode(c.Status)
+	for _, evt := range events {
+		p, ok := evt.Payload.(*event.StateChangePayload)
+		if !ok || (evt.Type != event.EventTypeStopped && evt.Type != event.EventTypeDied) {
+			continue
+		}
+		if evt.Type == event.EventTypeDied {
+			crashed = true
+		}
+		if code == 0 {
+			continue
+		}
+		crashed = true
+		p.ExitCode = code
+		if e.oomKilled(ctx, c.ID) {
+			evt.Type = event.EventTypeOOMKilled
+			evt.Message = fmt.Sprintf("Container %s was killed by the OOM killer", c.Name)
+			evt.Severity = event.SeverityCritical
+		} else if evt.Type == event.EventTypeStopped {
+			evt.Message = fmt.Sprintf("Container %s exited with code %d", c.Name, code)
+			evt.Severity = event.SeverityWarning
+		}
+		evt.SetPayload(p)
+	}
+	if !crashed {
+		return nil
+	}
+	if evt := e.detector.RecordCrash(c.ID, c.Name, c.Image, code, time.Now()); evt != nil {
+		evt.Labels = c.Labels
+		return []*event.Event{evt}
+	}
+	return nil
+}
+
+// oomKilled reports whether the kernel OOM killer stopped the container
+func (e *Engine) oomKilled(ctx context.Context, containerID string) bool {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	info, err := e.dockerClient.GetContainer(ctx, containerID)
+	if err != nil {
+		fmt.Printf("Error inspecting container %s: %v\n", containerID, err)
+		return false
+	}
+	return info.State != nil && info.State.OOMKilled
+}
+
+// deliver adds an event to the history and sends it to the event channel
+func (e *Engine) deliver(evt *event.Event) {
+	e.history.Add(evt)
+	select {
+	case e.eventChan <- evt:
+		e.stats.recordEvent(evt, false)
+	default:
+		e.stats.recordEvent(evt, true)
+		fmt.Printf("Warning: event channel is full, dropping event\n")
+	}
+}
+
+// captureLogs attaches the last log lines of the event's container to the event.
+// Runs right when the event is published, since the container (and its logs) may be removed soon after.
+func (e *Engine) captureLogs(evt *event.Event) {
+	ctx, cancel := context.WithTimeout(e.ctx, 5*time.Second)
+	defer cancel()
+
+	lines, err := e.dockerClient.GetContainerLogs(ctx, evt.ContainerID, docker.LogsOptions{
+		Tail: e.config.LogCapture.Lines,
+	})
+	if err != nil {
+		fmt.Printf("Error capturing logs of %s: %v\n", evt.ContainerName, err)
+		return
+	}
+	evt.Logs = make([]event.LogLine, 0, len(lines))
+	for _, line := range lines {
+		evt.Logs = append(evt.Logs, event.LogLine{
+			Stream:    line.Stream,
+			Timestamp: line.Timestamp,
+			Text:      line.Text,
+		})
+	}
+}
+
 // collectAndDetect collects container information and detects events
 func (e *Engine) collectAndDetect() {
 	ctx, cancel := context.WithTimeout(e.ctx, 30*time.Second)
@@ -123,16 +458,30 @@ func (e *Engine) collectAndDetect() {
 		ImagePattern: e.config.ImageNamePattern,
 	}
 
+	started := time.Now()
+	if e.waitingForDocker(started) {
+		return
+	}
 	containers, err := e.dockerClient.ListContainers(ctx, opts)
 	if err != nil {
-		fmt.Printf("Error listing containers: %v\n", err)
+		e.stats.recordCollection(started, time.Since(started), 0, err)
+		e.listFailed(err, started)
 		return
 	}
+	if e.conn.down {
+		e.dockerReconnected(len(containers), time.Now())
+	}
+	defer func() { e.stats.recordCollection(started, time.Since(started), len(containers), nil) }()
 
 	seenContainers := make(map[string]bool)
+	var running []docker.Container
+	var samples []tsdb.Sample
 
 	for _, container := range containers {
 		seenContainers[container.ID] = true
+		if container.State == "running" {
+			running = append(running, container)
+		}
 
 		oldState, exists := e.stateManager.GetState(container.ID)
 
@@ -140,26 +489,40 @@ func (e *Engine) collectAndDetect() {
 			ContainerID:   container.ID,
 			ContainerName: container.Name,
 			ImageName:     container.Image,
+			Labels:        container.Labels,
 			State:         container.State,
 			Status:        container.Status,
 			LastSeen:      time.Now(),
 		}
 
+		hasStats := false
 		if container.State == "running" {
 			stats, err := e.dockerClient.GetContainerStats(ctx, container.ID)
 			if err == nil {
+				hasStats = true
 				newState.CPUPercent = stats.CPUPercent
 				newState.MemoryUsage = stats.MemoryUsage
 				newState.MemoryLimit = stats.MemoryLimit
 				newState.MemoryPercent = stats.MemoryPercent
 				newState.NetworkRx = stats.NetworkRx
 				newState.NetworkTx = stats.NetworkTx
+				newState.BlockRead = stats.BlockRead
+				newState.BlockWrite = stats.BlockWrite
 			}
 		}
 
 		if exists {
 			newState.CPUThresholdCount = oldState.CPUThresholdCount
 			newState.MemoryThresholdCount = oldState.MemoryThresholdCount
+			newState.History = oldState.History
+		}
+		if newState.History == nil {
+			newState.History = NewMetricRing(e.config.MetricHistorySize)
+		}
+		if hasStats {
+			sample := metricSample(oldState, newState)
+			newState.History.Add(sample)
+			samples = append(samples, storeSample(newState, sample))
 		}
 
 		// Detect state changes before update (detector uses GetState, which still has old state)
@@ -173,12 +536,10 @@ func (e *Engine) collectAndDetect() {
 				container.State,
 			)
 			for _, evt := range events {
-				select {
-				case e.eventChan <- evt:
-				default:
-					fmt.Printf("Warning: event channel is full, dropping event\n")
-				}
+				evt.Labels = container.Labels
 			}
+			events = append(events, e.classifyExit(ctx, container, events)...)
+			e.publish(events)
 		}
 
 		e.stateManager.UpdateState(container.ID, newState)
@@ -192,13 +553,7 @@ func (e *Engine) collectAndDetect() {
 				newState.MemoryPercent,
 				newState,
 			)
-			for _, evt := range thresholdEvents {
-				select {
-				case e.eventChan <- evt:
-				default:
-					fmt.Printf("Warning: event channel is full, dropping event\n")
-				}
-			}
+			e.publish(thresholdEvents)
 		}
 	}
 
@@ -206,8 +561,58 @@ func (e *Engine) collectAndDetect() {
 	for containerID := range allStates {
 		if !seenContainers[containerID] {
 			e.stateManager.RemoveState(containerID)
+			e.detector.forgetCrashes(containerID)
+		}
+	}
+
+	if e.metricStore != nil {
+		if err := e.metricStore.Append(samples); err != nil {
+			fmt.Printf("Error storing metrics: %v\n", err)
 		}
 	}
+
+	e.publish(e.detector.FlushImpacts(time.Now()))
+
+	if e.logWatcher != nil {
+		e.logWatcher.Sync(e.ctx, running)
+	}
+}
+
+// metricSample builds a metric sample of current.
+// Rates are computed from the counters of previous, which may be nil.
+func metricSample(previous, current *ContainerState) MetricSample {
+	sample := MetricSample{
+		Timestamp:     current.LastSeen,
+		CPUPercent:    current.CPUPercent,
+		MemoryUsage:   current.MemoryUsage,
+		MemoryPercent: current.MemoryPercent,
+	}
+	if previous == nil || previous.State != "running" {
+		return sample
+	}
+	elapsed := current.LastSeen.Sub(previous.LastSeen)
+	sample.NetworkRxRate = counterRate(previous.NetworkRx, current.NetworkRx, elapsed)
+	sample.NetworkTxRate = counterRate(previous.NetworkTx, current.NetworkTx, elapsed)
+	sample.BlockReadRate = counterRate(previous.BlockRead, current.BlockRead, elapsed)
+	sample.BlockWriteRate = counterRate(previous.BlockWrite, current.BlockWrite, elapsed)
+	return sample
+}
+
+// storeSample converts a metric sample to a sample of the metric store
+func storeSample(state *ContainerState, sample MetricSample) tsdb.Sample {
+	s := tsdb.Sample{
+		Time:          sample.Timestamp,
+		ContainerID:   state.ContainerID,
+		ContainerName: state.ContainerName,
+	}
+	s.Values[tsdb.MetricCPUPercent] = sample.CPUPercent
+	s.Values[tsdb.MetricMemoryUsage] = float64(sample.MemoryUsage)
+	s.Values[tsdb.MetricMemoryPercent] = sample.MemoryPercent
+	s.Values[tsdb.MetricNetworkRxRate] = sample.NetworkRxRate
+	s.Values[tsdb.MetricNetworkTxRate] = sample.NetworkTxRate
+	s.Values[tsdb.MetricBlockReadRate] = sample.BlockReadRate
+	s.Values[tsdb.MetricBlockWriteRate] = sample.BlockWriteRate
+	return s
 }
 
 // GetEventChannel returns the event channel
@@ -215,6 +620,43 @@ func (e *Engine) GetEventChannel() <-chan *event.Event {
 	return e.eventChan
 }
 
+// GetDockerClient returns the Docker client used by the engine
+func (e *Engine) GetDockerClient() *docker.Client {
+	return e.dockerClient
+}
+
+// GetEventHistory returns the event history
+func (e *Engine) GetEventHistory() *EventHistory {
+	return e.history
+}
+
+// GetMetricStore returns the metric store, or nil if metric storage is disabled
+func (e *Engine) GetMetricStore() *tsdb.Store {
+	return e.metricStore
+}
+
+// GetStats returns a copy of the engine's own counters
+func (e *Engine) GetStats() EngineStats {
+	return e.stats.snapshot()
+}
+
+// CheckHealth returns nil if the last container collection succeeded recently.
+// Collections list containers, so a failing one also means Docker is unreachable.
+func (e *Engine) CheckHealth() error {
+	stats := e.stats.snapshot()
+	switch {
+	case stats.Collections == 0:
+		return fmt.Errorf("no collection yet")
+	case stats.LastCollectError != nil:
+		return fmt.Errorf("last collection failed: %w", stats.LastCollectError)
+	}
+	// A hung collection never records an error; treat missed intervals as unhealthy
+	if stale := 3*e.config.Interval + 30*time.Second; time.Since(stats.LastCollectAt) > stale {
+		return fmt.Errorf("no collection since %s", stats.LastCollectAt.Format(time.DateTime))
+	}
+	return nil
+}
+
 // GetStateManager returns the state manager
 func (e *Engine) GetStateManager() *StateManager {
 	return e.stateManager
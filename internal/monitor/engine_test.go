@@ -2,10 +2,13 @@ package monitor
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"docksphinx/internal/docker"
+	"docksphinx/internal/event"
+	"docksphinx/internal/graph"
 )
 
 func TestStateManager(t *testing.T) {
@@ -133,6 +136,9 @@ func TestThresholdMonitor(t *testing.T) {
 	if events[0].Type != "cpu_threshold" {
 		t.Errorf("Expected 'cpu_threshold' event, got '%s'", events[0].Type)
 	}
+	if level := events[0].Data["level"]; level != events[0].Severity.String() {
+		t.Errorf("Expected level %s in data, got %v", events[0].Severity, level)
+	}
 	if state.CPUThresholdCount != 0 {
 		t.Errorf("Expected CPU count to be reset to 0, got %d", state.CPUThresholdCount)
 	}
@@ -177,3 +183,358 @@ func TestEngineIntegration(t *testing.T) {
 	states := engine.GetStateManager().GetAllStates()
 	t.Logf("Tracking %d containers", len(states))
 }
+
+func TestStateManagerInventory(t *testing.T) {
+	sm := NewStateManager()
+
+	inventory := sm.GetInventory()
+	if !inventory.CollectedAt.IsZero() {
+		t.Error("Expected empty inventory before first collection")
+	}
+
+	sm.UpdateInventory(ResourceInventory{
+		Images:      []docker.Image{{ID: "sha256:abc", Repository: "nginx", Tag: "latest"}},
+		Networks:    []docker.Network{{ID: "net1", Name: "bridge", Labels: map[string]string{"env": "prod"}}},
+		Volumes:     []docker.Volume{{Name: "data", Labels: map[string]string{"env": "prod"}}},
+		CollectedAt: time.Now(),
+	})
+
+	inventory = sm.GetInventory()
+	if len(inventory.Images) != 1 || inventory.Images[0].Repository != "nginx" {
+		t.Errorf("Expected 1 nginx image, got %+v", inventory.Images)
+	}
+	if len(inventory.Networks) != 1 || len(inventory.Volumes) != 1 {
+		t.Errorf("Expected 1 network and 1 volume, got %d and %d", len(inventory.Networks), len(inventory.Volumes))
+	}
+
+	// Returned slices and label maps are copies
+	inventory.Images[0].Repository = "changed"
+	inventory.Networks[0].Labels["env"] = "changed"
+	inventory.Volumes[0].Labels["env"] = "changed"
+	inventory = sm.GetInventory()
+	if inventory.Images[0].Repository != "nginx" || inventory.Networks[0].Labels["env"] != "prod" || inventory.Volumes[0].Labels["env"] != "prod" {
+		t.Error("Expected inventory to be copied on read")
+	}
+}
+
+func TestVolumeGrowth(t *testing.T) {
+	config := DefaultThresholdConfig()
+	config.Volume.GrowthBytes = 100
+	th := NewThresholdMonitor(config)
+
+	previous := &docker.DiskUsage{Volumes: []docker.VolumeDiskUsage{
+		{Name: "data", Size: 1000, RefCount: 1},
+		{Name: "cache", Size: 1000, RefCount: 1},
+		{Name: "remote", Size: -1, RefCount: -1},
+	}}
+	current := &docker.DiskUsage{Volumes: []docker.VolumeDiskUsage{
+		{Name: "data", Size: 1150, RefCount: 1},
+		{Name: "cache", Size: 1050, RefCount: 1},
+		{Name: "remote", Size: -1, RefCount: -1},
+		{Name: "new", Size: 5000, RefCount: 0},
+	}}
+
+	events := th.CheckVolumeGrowth(nil, current)
+	if len(events) != 0 {
+		t.Errorf("Expected no events without a previous collection, got %d", len(events))
+	}
+
+	events = th.CheckVolumeGrowth(previous, current)
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(events))
+	}
+	if events[0].Type != "volume_growth" {
+		t.Errorf("Expected 'volume_growth' event, got '%s'", events[0].Type)
+	}
+	if events[0].Data["volume_name"] != "data" {
+		t.Errorf("Expected volume 'data', got '%v'", events[0].Data["volume_name"])
+	}
+}
+
+func TestDetectorImpact(t *testing.T) {
+	sm := NewStateManager()
+	detector := NewDetector(sm)
+
+	sm.UpdateDependencyGraph(graph.Build([]graph.Container{
+		{ID: "db", Name: "shop-db-1", Labels: map[string]string{
+			graph.LabelComposeProject: "shop", graph.LabelComposeService: "db"}},
+		{ID: "api", Name: "shop-api-1", Labels: map[string]string{
+			graph.LabelComposeProject: "shop", graph.LabelComposeService: "api",
+			graph.LabelComposeDependsOn: "db:service_started:false"}},
+		{ID: "worker", Name: "shop-worker-1", Labels: map[string]string{
+			graph.LabelComposeProject: "shop", graph.LabelComposeService: "worker",
+			graph.LabelComposeDependsOn: "api:service_started:false"}},
+	}, nil), time.Now())
+
+	for _, id := range []string{"db", "api", "worker"} {
+		sm.UpdateState(id, &ContainerState{ContainerID: id, State: "running", LastSeen: time.Now()})
+	}
+
+	events := detector.DetectStateChange("db", "shop-db-1", "postgres", "exited")
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(events))
+	}
+	if events[0].Data["dependents"] != "shop-api-1" {
+		t.Errorf("Expected dependents 'shop-api-1', got '%v'", events[0].Data["dependents"])
+	}
+	payload, ok := events[0].Payload.(*event.StateChangePayload)
+	if !ok || payload.PreviousState != "running" || len(payload.DependentIDs) != 1 || payload.DependentIDs[0] != "api" {
+		t.Errorf("Unexpected state change payload: %+v", events[0].Payload)
+	}
+
+	detector.DetectStateChange("api", "shop-api-1", "api", "exited")
+	detector.DetectStateChange("worker", "shop-worker-1", "worker", "exited")
+
+	if impacts := detector.FlushImpacts(time.Now()); len(impacts) != 0 {
+		t.Errorf("Expected no impact event before the window closes, got %d", len(impacts))
+	}
+
+	impacts := detector.FlushImpacts(time.Now().Add(DefaultImpactWindow + time.Second))
+	if len(impacts) != 1 {
+		t.Fatalf("Expected 1 impact event, got %d", len(impacts))
+	}
+	if impacts[0].Type != "impact" || impacts[0].ContainerID != "db" {
+		t.Errorf("Expected impact event for 'db', got '%s' for '%s'", impacts[0].Type, impacts[0].ContainerID)
+	}
+	if impacts[0].Data["affected"] != "shop-api-1,shop-worker-1" {
+		t.Errorf("Expected affected 'shop-api-1,shop-worker-1', got '%v'", impacts[0].Data["affected"])
+	}
+
+	if impacts := detector.FlushImpacts(time.Now().Add(2 * DefaultImpactWindow)); len(impacts) != 0 {
+		t.Errorf("Expected impact event to be emitted once, got %d more", len(impacts))
+	}
+}
+
+func TestLogMatcher(t *testing.T) {
+	patterns, err := compileLogPatterns([]LogPattern{
+		{Name: "panic", Regex: "panic:", ContextLines: 1, RateLimit: time.Minute},
+		{Name: "http_5xx", Regex: `" 5\d\d `, MinCount: 3, Window: 10 * time.Second},
+	})
+	if err != nil {
+		t.Fatalf("Failed to compile patterns: %v", err)
+	}
+	if _, err := compileLogPatterns([]LogPattern{{Regex: "("}}); err == nil {
+		t.Error("Expected error for invalid regex")
+	}
+	if _, err := compileLogPatterns([]LogPattern{{Regex: "5xx", MinCount: 3}}); err == nil {
+		t.Error("Expected error for min count without window")
+	}
+
+	m := newLogMatcher("c1", "api", "api-image", patterns)
+	now := time.Now()
+	line := func(text string) docker.LogLine {
+		return docker.LogLine{Stream: "stderr", Text: text}
+	}
+
+	if events := m.process(line("starting"), now); len(events) != 0 {
+		t.Errorf("Expected no events, got %d", len(events))
+	}
+	if events := m.process(line("panic: nil map"), now); len(events) != 0 {
+		t.Errorf("Expected event to wait for trailing context, got %d", len(events))
+	}
+	events := m.process(line("goroutine 1 [running]:"), now)
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(events))
+	}
+	if events[0].Type != "log_match" || events[0].Data["pattern"] != "panic" {
+		t.Errorf("Expected log_match for 'panic', got '%s' for '%v'", events[0].Type, events[0].Data["pattern"])
+	}
+	if events[0].Data["context"] != "starting\npanic: nil map\ngoroutine 1 [running]:" {
+		t.Errorf("Unexpected context: %q", events[0].Data["context"])
+	}
+
+	// Rate limited
+	m.process(line("panic: again"), now.Add(time.Second))
+	if events := m.flush(); len(events) != 0 {
+		t.Errorf("Expected rate limited match to be suppressed, got %d", len(events))
+	}
+
+	// Rate detection
+	for i := 0; i < 2; i++ {
+		if events := m.process(line(`"GET / HTTP/1.1" 502 0`), now.Add(time.Duration(i)*time.Second)); len(events) != 0 {
+			t.Errorf("Expected no event below min count, got %d", len(events))
+		}
+	}
+	events = m.process(line(`"GET / HTTP/1.1" 503 0`), now.Add(2*time.Second))
+	if len(events) != 1 || events[0].Data["match_count"] != 3 {
+		t.Fatalf("Expected 1 event with 3 matches, got %+v", events)
+	}
+
+	// A match fires without trailing context once the container stays quiet
+	later := now.Add(time.Hour)
+	if events := m.process(line("panic: deadlock"), later); len(events) != 0 {
+		t.Errorf("Expected event to wait for trailing context, got %d", len(events))
+	}
+	if events := m.expire(later.Add(time.Second)); len(events) != 0 {
+		t.Errorf("Expected event to wait until its deadline, got %d", len(events))
+	}
+	events = m.expire(later.Add(maxContextWait + time.Second))
+	if len(events) != 1 || events[0].Data["pattern"] != "panic" {
+		t.Fatalf("Expected the pending panic match after its deadline, got %+v", events)
+	}
+	if len(m.pending) != 0 {
+		t.Errorf("Expected no pending matches, got %d", len(m.pending))
+	}
+}
+
+func TestEventHistory(t *testing.T) {
+	h := NewEventHistory(3)
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		h.Add(event.NewEvent(event.EventTypeStarted, name, name, "image"))
+	}
+	died := event.NewEvent(event.EventTypeDied, "b", "b", "image")
+	died.Severity = event.SeverityCritical
+	h.Add(died)
+
+	if h.Len() != 3 {
+		t.Fatalf("Expected 3 events, got %d", h.Len())
+	}
+
+	all := h.Query(EventQuery{})
+	var ids []string
+	for _, evt := range all {
+		ids = append(ids, evt.ContainerID)
+	}
+	if len(ids) != 3 || ids[0] != "c" || ids[1] != "d" || ids[2] != "b" {
+		t.Errorf("Expected oldest-first [c d b], got %v", ids)
+	}
+
+	byType := h.Query(EventQuery{Types: []event.EventType{event.EventTypeDied}})
+	if len(byType) != 1 || byType[0].ContainerID != "b" {
+		t.Errorf("Expected 1 died event for 'b', got %+v", byType)
+	}
+
+	critical := h.Query(EventQuery{MinSeverity: event.SeverityCritical})
+	if len(critical) != 1 || critical[0].Type != event.EventTypeDied {
+		t.Errorf("Expected only the critical 'died' event, got %+v", critical)
+	}
+
+	latest := h.Query(EventQuery{Limit: 1})
+	if len(latest) != 1 || latest[0].Type != event.EventTypeDied {
+		t.Errorf("Expected most recent event to be 'died', got %+v", latest)
+	}
+}
+
+func TestMetricHistory(t *testing.T) {
+	start := time.Now()
+	previous := &ContainerState{State: "running", LastSeen: start, NetworkRx: 1000, BlockWrite: 500}
+	current := &ContainerState{State: "running", LastSeen: start.Add(2 * time.Second), NetworkRx: 3000, BlockWrite: 100, CPUPercent: 12.5}
+
+	sample := metricSample(previous, current)
+	if sample.NetworkRxRate != 1000 {
+		t.Errorf("Expected network rx rate 1000 B/s, got %f", sample.NetworkRxRate)
+	}
+	if sample.BlockWriteRate != 0 {
+		t.Errorf("Expected block write rate 0 after counter reset, got %f", sample.BlockWriteRate)
+	}
+	if first := metricSample(nil, current); first.NetworkRxRate != 0 || first.CPUPercent != 12.5 {
+		t.Errorf("Expected first sample without rates, got %+v", first)
+	}
+
+	r := NewMetricRing(3)
+	for i := 1; i <= 4; i++ {
+		r.Add(MetricSample{CPUPercent: float64(i)})
+	}
+	samples := r.Samples()
+	if len(samples) != 3 || samples[0].CPUPercent != 2 || samples[2].CPUPercent != 4 {
+		t.Errorf("Expected oldest-first samples [2 3 4], got %+v", samples)
+	}
+}
+
+func TestEngineHealth(t *testing.T) {
+	e := &Engine{config: EngineConfig{Interval: time.Second}, stats: newStatsRecorder()}
+	if err := e.CheckHealth(); err == nil {
+		t.Error("Expected unhealthy engine before the first collection")
+	}
+	e.stats.recordCollection(time.Now(), time.Millisecond, 3, nil)
+	if err := e.CheckHealth(); err != nil {
+		t.Errorf("Expected healthy engine, got %v", err)
+	}
+	e.stats.recordCollection(time.Now(), time.Millisecond, 0, errors.New("docker not running"))
+	if err := e.CheckHealth(); err == nil {
+		t.Error("Expected unhealthy engine after a failed collection")
+	}
+	e.stats.recordCollection(time.Now().Add(-time.Hour), time.Millisecond, 3, nil)
+	if err := e.CheckHealth(); err == nil {
+		t.Error("Expected unhealthy engine after missed collections")
+	}
+}
+
+func TestEngineReconnect(t *testing.T) {
+	sm := NewStateManager()
+	e := &Engine{
+		config:       EngineConfig{Interval: time.Second},
+		stateManager: sm,
+		history:      NewEventHistory(10),
+		stats:        newStatsRecorder(),
+		eventChan:    make(chan *event.Event, 10),
+	}
+	sm.UpdateState("c1", &ContainerState{ContainerID: "c1", ContainerName: "web", State: "running", CPUPercent: 42})
+	sm.UpdateState("c2", &ContainerState{ContainerID: "c2", ContainerName: "db", State: "exited"})
+
+	now := time.Now()
+	e.dockerDisconnected(docker.ErrDockerNotRunning, now)
+	evt := <-e.eventChan
+	if evt.Type != event.EventTypeDockerDisconnected || evt.Severity != event.SeverityCritical {
+		t.Errorf("Expected critical docker_disconnected event, got %s %s", evt.Type, evt.Severity)
+	}
+	if st, _ := sm.GetState("c1"); st.State != StateUnknown || st.CPUPercent != 0 {
+		t.Errorf("Expected unknown state without metrics, got %s with %.0f%% CPU", st.State, st.CPUPercent)
+	}
+
+	// Failed retries back off exponentially without further events, whatever the error
+	e.listFailed(docker.ErrDockerNotRunning, now.Add(time.Second))
+	e.listFailed(errors.New("unexpected EOF"), now.Add(3*time.Second))
+	if e.conn.backoff != 4*time.Second || !e.waitingForDocker(now.Add(5*time.Second)) {
+		t.Errorf("Expected backoff of 4s, got %s", e.conn.backoff)
+	}
+	if len(e.eventChan) != 0 {
+		t.Errorf("Expected no events while retrying, got %d", len(e.eventChan))
+	}
+
+	e.dockerReconnected(2, now.Add(10*time.Second))
+	evt = <-e.eventChan
+	if p, ok := evt.Payload.(*event.DockerConnectionPayload); evt.Type != event.EventTypeDockerReconnected || !ok || p.Attempts != 3 || p.Downtime != 10*time.Second {
+		t.Errorf("Expected docker_reconnected after 3 attempts and 10s, got %s %+v", evt.Type, evt.Payload)
+	}
+	// Restored states let the resync collection detect only real changes
+	if st, _ := sm.GetState("c1"); st.State != "running" {
+		t.Errorf("Expected restored state running, got %s", st.State)
+	}
+	if events := NewDetector(sm).DetectStateChange("c1", "web", "", "running"); len(events) != 0 {
+		t.Errorf("Expected no started event for a container still running, got %d", len(events))
+	}
+	if e.waitingForDocker(now.Add(10 * time.Second)) {
+		t.Error("Expected no backoff after reconnecting")
+	}
+}
+
+func TestCrashLoop(t *testing.T) {
+	if code, ok := docker.ExitCode("Exited (137) 5 seconds ago"); !ok || code != 137 {
+		t.Errorf("Expected exit code 137, got %d (%v)", code, ok)
+	}
+	if _, ok := docker.ExitCode("Up 2 minutes"); ok {
+		t.Error("Expected no exit code for a running container")
+	}
+
+	d := NewDetector(NewStateManager())
+	now := time.Now()
+	// The first crash falls out of the window before the loop is detected
+	d.RecordCrash("c1", "worker", "img", 1, now.Add(-DefaultCrashLoopWindow))
+	for i := 0; i < DefaultCrashLoopCount-1; i++ {
+		if evt := d.RecordCrash("c1", "worker", "img", 1, now.Add(time.Duration(i)*time.Second)); evt != nil {
+			t.Fatalf("Expected no crash loop after %d recent crashes", i+1)
+		}
+	}
+	evt := d.RecordCrash("c1", "worker", "img", 2, now.Add(time.Minute))
+	if evt == nil || evt.Type != event.EventTypeCrashLoop || evt.Severity != event.SeverityCritical {
+		t.Fatalf("Expected critical crash_loop event, got %+v", evt)
+	}
+	if p, ok := evt.Payload.(*event.CrashLoopPayload); !ok || p.Crashes != DefaultCrashLoopCount || p.ExitCode != 2 {
+		t.Errorf("Unexpected crash loop payload: %+v", evt.Payload)
+	}
+	if evt := d.RecordCrash("c1", "worker", "img", 1, now.Add(2*time.Minute)); evt != nil {
+		t.Error("Expected the crash count to start over after a crash loop")
+	}
+}
@@ -0,0 +1,98 @@
+package monitor
+
+import (
+	"sync"
+	"time"
+
+	"docksphinx/internal/event"
+)
+
+// DefaultEventHistorySize is the default number of events kept in memory
+const DefaultEventHistorySize = 1000
+
+// EventHistory keeps the most recent events in memory (ring buffer)
+type EventHistory struct {
+	mu     sync.RWMutex
+	events []*event.Event
+	next   int // Index of the next write once the buffer is full
+	size   int
+}
+
+// NewEventHistory creates a new event history holding at most size events
+func NewEventHistory(size int) *EventHistory {
+	if size <= 0 {
+		size = DefaultEventHistorySize
+	}
+	return &EventHistory{
+		events: make([]*event.Event, 0, size),
+		size:   size,
+	}
+}
+
+// Add appends an event, evicting the oldest one if the history is full
+func (h *EventHistory) Add(evt *event.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.events) < h.size {
+		h.events = append(h.events, evt)
+		return
+	}
+	h.events[h.next] = evt
+	h.next = (h.next + 1) % h.size
+}
+
+// EventQuery filters events returned by Query
+type EventQuery struct {
+	ContainerID string            // Container ID or name (empty matches all)
+	Types       []event.EventType // Empty matches all
+	Since       time.Time         // Zero matches all
+	MinSeverity event.Severity    // Events less severe are skipped
+	Limit       int               // Maximum number of (most recent) events, 0 means no limit
+}
+
+// Query returns matching events, oldest first
+func (h *EventHistory) Query(q EventQuery) []*event.Event {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	types := make(map[event.EventType]bool, len(q.Types))
+	for _, t := range q.Types {
+		types[t] = true
+	}
+
+	var result []*event.Event
+	// Walk newest to oldest so Limit keeps the most recent events
+	for i := len(h.events) - 1; i >= 0; i-- {
+		evt := h.events[(h.next+i)%len(h.events)]
+		if q.ContainerID != "" && evt.ContainerID != q.ContainerID && evt.ContainerName != q.ContainerID {
+			continue
+		}
+		if len(types) > 0 && !types[evt.Type] {
+			continue
+		}
+		if !q.Since.IsZero() && evt.Timestamp.Before(q.Since) {
+			continue
+		}
+		if evt.Severity < q.MinSeverity {
+			continue
+		}
+		result = append(result, evt)
+		if q.Limit > 0 && len(result) >= q.Limit {
+			break
+		}
+	}
+
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result
+}
+
+// Len returns the number of events in the history
+func (h *EventHistory) Len() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.events)
+}
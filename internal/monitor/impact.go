@@ -0,0 +1,164 @@
+package monitor
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"docksphinx/internal/event"
+	"docksphinx/internal/graph"
+)
+
+// DefaultImpactWindow is the default window in which failures of dependents are attributed to a failed dependency
+const DefaultImpactWindow = 60 * time.Second
+
+// failure records a container failure (died/stopped) for impact correlation
+type failure struct {
+	containerID   string
+	containerName string
+	imageName     string
+	at            time.Time
+}
+
+// impactGroup collects failures of dependents attributed to a root cause
+type impactGroup struct {
+	root     failure
+	affected []failure
+}
+
+// annotateDependents adds the dependents of the container to a died/stopped event
+func annotateDependents(evt *event.Event, g *graph.Graph) {
+	if g == nil {
+		return
+	}
+	ids := g.Dependents(evt.ContainerID)
+	if len(ids) == 0 {
+		return
+	}
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		names = append(names, nodeName(g, id))
+	}
+	p, ok := evt.Payload.(*event.StateChangePayload)
+	if !ok {
+		p = &event.StateChangePayload{}
+	}
+	p.Dependents = names
+	p.DependentIDs = ids
+	evt.SetPayload(p)
+}
+
+// recordFailure records a container failure and attributes it to a recently failed dependency.
+// Must be called with d.mu held.
+func (d *Detector) recordFailure(f failure, g *graph.Graph) {
+	d.pruneFailures(f.at)
+	d.failures[f.containerID] = f
+
+	if g == nil {
+		return
+	}
+
+	// Find the earliest failed dependency within the window
+	var root *failure
+	for _, depID := range g.Dependencies(f.containerID) {
+		dep, ok := d.failures[depID]
+		if !ok || dep.at.After(f.at) || f.at.Sub(dep.at) > d.impactWindow {
+			continue
+		}
+		// A dependency that was itself affected by something else points to that root cause
+		if rootID, ok := d.affectedBy[depID]; ok {
+			if group, ok := d.impacts[rootID]; ok {
+				dep = group.root
+			}
+		}
+		if dep.containerID == f.containerID {
+			continue
+		}
+		if root == nil || dep.at.Before(root.at) {
+			candidate := dep
+			root = &candidate
+		}
+	}
+	if root == nil {
+		return
+	}
+
+	group, ok := d.impacts[root.containerID]
+	if !ok {
+		group = &impactGroup{root: *root}
+		d.impacts[root.containerID] = group
+	}
+	for _, a := range group.affected {
+		if a.containerID == f.containerID {
+			return
+		}
+	}
+	group.affected = append(group.affected, f)
+	d.affectedBy[f.containerID] = root.containerID
+}
+
+// pruneFailures forgets failures that can no longer start or join an impact group.
+// Must be called with d.mu held.
+func (d *Detector) pruneFailures(now time.Time) {
+	for id, f := range d.failures {
+		if now.Sub(f.at) > d.impactWindow {
+			if _, open := d.impacts[id]; !open {
+				delete(d.failures, id)
+			}
+		}
+	}
+}
+
+// FlushImpacts returns one impact event for each root cause whose window has closed.
+// Call periodically (e.g. once per collection).
+func (d *Detector) FlushImpacts(now time.Time) []*event.Event {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var events []*event.Event
+	for rootID, group := range d.impacts {
+		if now.Sub(group.root.at) < d.impactWindow {
+			continue
+		}
+		delete(d.impacts, rootID)
+		for _, a := range group.affected {
+			delete(d.affectedBy, a.containerID)
+		}
+		if len(group.affected) == 0 {
+			continue
+		}
+
+		sort.Slice(group.affected, func(i, j int) bool { return group.affected[i].at.Before(group.affected[j].at) })
+		names := make([]string, 0, len(group.affected))
+		ids := make([]string, 0, len(group.affected))
+		for _, a := range group.affected {
+			names = append(names, a.containerName)
+			ids = append(ids, a.containerID)
+		}
+
+		root := group.root
+		evt := event.NewEvent(event.EventTypeImpact, root.containerID, root.containerName, root.imageName)
+		evt.Message = fmt.Sprintf("Failure of container %s affected %d dependent container(s): %s",
+			root.containerName, len(names), strings.Join(names, ", "))
+		evt.Severity = event.SeverityCritical
+		evt.SetPayload(&event.ImpactPayload{
+			RootCauseFailedAt: root.at,
+			Affected:          names,
+			AffectedIDs:       ids,
+			Window:            d.impactWindow,
+		})
+		events = append(events, evt)
+	}
+	d.pruneFailures(now)
+
+	return events
+}
+
+// nodeName returns the container name for a graph node, falling back to the ID
+func nodeName(g *graph.Graph, id string) string {
+	if n, ok := g.Node(id); ok && n.Name != "" {
+		return n.Name
+	}
+	return id
+}
@@ -0,0 +1,382 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"docksphinx/internal/docker"
+	"docksphinx/internal/event"
+)
+
+// LogWatchConfig represents log pattern detection configuration
+type LogWatchConfig struct {
+	// Regex pattern selecting containers whose logs are followed (empty disables log watching)
+	ContainerNamePattern string
+	Patterns             []LogPattern
+}
+
+// LogPattern represents a pattern to detect in container logs
+type LogPattern struct {
+	Name   string // Name reported in events (defaults to Regex)
+	Regex  string // Regular expression matched against each line
+	Stream string // "stdout", "stderr" or empty for both
+	Level  string // Event severity: info, warning or critical (default: "warning")
+
+	// Rate detection: an event is generated once MinCount lines matched within Window
+	// (e.g. HTTP 5xx responses). MinCount <= 1 generates an event for every match;
+	// MinCount > 1 requires a positive Window.
+	MinCount int
+	Window   time.Duration
+
+	// Minimum interval between events for this pattern and container; suppressed matches are counted
+	RateLimit time.Duration
+
+	// Number of lines captured before and after the matching line
+	ContextLines int
+}
+
+// maxContextWait bounds the time a match waits for its trailing context lines, so that
+// a container that hangs after logging e.g. a panic still generates the event
+const maxContextWait = 5 * time.Second
+
+// compiledPattern is a LogPattern with its compiled regex
+type compiledPattern struct {
+	LogPattern
+	re       *regexp.Regexp
+	severity event.Severity
+}
+
+// compileLogPatterns validates and compiles log patterns
+func compileLogPatterns(patterns []LogPattern) ([]compiledPattern, error) {
+	result := make([]compiledPattern, 0, len(patterns))
+	for _, p := range patterns {
+		re, err := regexp.Compile(p.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log pattern %q: %w", p.Regex, err)
+		}
+		if p.Name == "" {
+			p.Name = p.Regex
+		}
+		if p.MinCount > 1 && p.Window <= 0 {
+			return nil, fmt.Errorf("log pattern %s: min_count > 1 requires a window", p.Name)
+		}
+		if p.Level == "" {
+			p.Level = "warning"
+		}
+		severity, err := event.ParseSeverity(p.Level)
+		if err != nil {
+			return nil, fmt.Errorf("log pattern %s: %w", p.Name, err)
+		}
+		result = append(result, compiledPattern{LogPattern: p, re: re, severity: severity})
+	}
+	return result, nil
+}
+
+// LogWatcher follows logs of selected containers and generates log_match events
+type LogWatcher struct {
+	client    *docker.Client
+	nameRe    *regexp.Regexp
+	patterns  []compiledPattern
+	publish   func([]*event.Event)
+	wg        *sync.WaitGroup
+	mu        sync.Mutex
+	followers map[string]*logFollower // Key: ContainerID
+}
+
+// logFollower is a running log stream of one container
+type logFollower struct {
+	cancel context.CancelFunc
+}
+
+// NewLogWatcher creates a new log watcher. Returns nil if log watching is disabled.
+func NewLogWatcher(config LogWatchConfig, client *docker.Client, publish func([]*event.Event), wg *sync.WaitGroup) (*LogWatcher, error) {
+	if config.ContainerNamePattern == "" || len(config.Patterns) == 0 {
+		return nil, nil
+	}
+	nameRe, err := regexp.Compile(config.ContainerNamePattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log watch container pattern: %w", err)
+	}
+	patterns, err := compileLogPatterns(config.Patterns)
+	if err != nil {
+		return nil, err
+	}
+	return &LogWatcher{
+		client:    client,
+		nameRe:    nameRe,
+		patterns:  patterns,
+		publish:   publish,
+		wg:        wg,
+		followers: make(map[string]*logFollower),
+	}, nil
+}
+
+// Sync starts following newly running containers and stops following containers that are gone.
+// ctx bounds the lifetime of all followers.
+func (lw *LogWatcher) Sync(ctx context.Context, running []docker.Container) {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+
+	current := make(map[string]bool)
+	for _, c := range running {
+		if !lw.nameRe.MatchString(c.Name) {
+			continue
+		}
+		current[c.ID] = true
+		if _, ok := lw.followers[c.ID]; ok {
+			continue
+		}
+		followCtx, cancel := context.WithCancel(ctx)
+		f := &logFollower{cancel: cancel}
+		lw.followers[c.ID] = f
+		lw.wg.Add(1)
+		go lw.follow(followCtx, f, c)
+	}
+
+	for id, f := range lw.followers {
+		if !current[id] {
+			f.cancel()
+			delete(lw.followers, id)
+		}
+	}
+}
+
+// follow streams logs of one container until it stops or ctx is canceled
+func (lw *LogWatcher) follow(ctx context.Context, f *logFollower, c docker.Container) {
+	defer lw.wg.Done()
+	defer func() {
+		f.cancel()
+		lw.mu.Lock()
+		// The entry may already belong to a newer follower of the same container
+		if lw.followers[c.ID] == f {
+			delete(lw.followers, c.ID)
+		}
+		lw.mu.Unlock()
+	}()
+
+	m := newLogMatcher(c.ID, c.Name, c.Image, lw.patterns)
+	var mu sync.Mutex // Guards m between the stream and the ticker
+
+	// Release matches whose trailing context does not arrive in time
+	done := make(chan struct{})
+	var ticker sync.WaitGroup
+	ticker.Add(1)
+	go func() {
+		defer ticker.Done()
+		t := time.NewTicker(time.Second)
+		defer t.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-t.C:
+				mu.Lock()
+				lw.publish(m.expire(now))
+				mu.Unlock()
+			}
+		}
+	}()
+
+	// Only new output is inspected; existing lines were written before watching started
+	opts := docker.LogsOptions{Since: time.Now()}
+	err := lw.client.FollowContainerLogs(ctx, c.ID, opts, func(line docker.LogLine) error {
+		mu.Lock()
+		defer mu.Unlock()
+		lw.publish(m.process(line, time.Now()))
+		return nil
+	})
+	close(done)
+	ticker.Wait()
+	lw.publish(m.flush())
+	if err != nil && ctx.Err() == nil {
+		fmt.Printf("Error following logs of %s: %v\n", c.Name, err)
+	}
+}
+
+// logMatcher applies patterns to the log lines of a single container
+// Not safe for concurrent use; each follower owns one matcher.
+type logMatcher struct {
+	containerID   string
+	containerName string
+	imageName     string
+	patterns      []compiledPattern
+	state         []patternState
+
+	before  []string // Last lines for context, up to maxContextLines
+	pending []*pendingMatch
+	maxCtx  int
+}
+
+// patternState tracks rate detection and rate limiting for one pattern
+type patternState struct {
+	matches    []time.Time // Match times within Window
+	lastEvent  time.Time
+	suppressed int
+}
+
+// pendingMatch is a match waiting for its trailing context lines
+type pendingMatch struct {
+	evt       *event.Event
+	context   []string
+	remaining int
+	deadline  time.Time // Released with the context captured so far after this time
+}
+
+func newLogMatcher(containerID, containerName, imageName string, patterns []compiledPattern) *logMatcher {
+	maxCtx := 0
+	for _, p := range patterns {
+		if p.ContextLines > maxCtx {
+			maxCtx = p.ContextLines
+		}
+	}
+	return &logMatcher{
+		containerID:   containerID,
+		containerName: containerName,
+		imageName:     imageName,
+		patterns:      patterns,
+		state:         make([]patternState, len(patterns)),
+		maxCtx:        maxCtx,
+	}
+}
+
+// process handles one log line and returns events whose context is complete
+func (m *logMatcher) process(line docker.LogLine, now time.Time) []*event.Event {
+	var ready []*event.Event
+
+	// Complete trailing context of earlier matches
+	kept := m.pending[:0]
+	for _, p := range m.pending {
+		p.context = append(p.context, line.Text)
+		p.remaining--
+		if p.remaining <= 0 {
+			ready = append(ready, p.finish())
+		} else {
+			kept = append(kept, p)
+		}
+	}
+	m.pending = kept
+
+	for i, p := range m.patterns {
+		if p.Stream != "" && p.Stream != line.Stream {
+			continue
+		}
+		if !p.re.MatchString(line.Text) {
+			continue
+		}
+		if evt := m.match(i, line, now); evt != nil {
+			pm := &pendingMatch{evt: evt, remaining: p.ContextLines, deadline: now.Add(maxContextWait)}
+			pm.context = append(pm.context, lastN(m.before, p.ContextLines)...)
+			pm.context = append(pm.context, line.Text)
+			if pm.remaining <= 0 {
+				ready = append(ready, pm.finish())
+			} else {
+				m.pending = append(m.pending, pm)
+			}
+		}
+	}
+
+	if m.maxCtx > 0 {
+		m.before = append(m.before, line.Text)
+		if len(m.before) > m.maxCtx {
+			m.before = m.before[len(m.before)-m.maxCtx:]
+		}
+	}
+
+	return ready
+}
+
+// match records a match and returns an event if the pattern triggers and is not rate limited
+func (m *logMatcher) match(i int, line docker.LogLine, now time.Time) *event.Event {
+	p := m.patterns[i]
+	st := &m.state[i]
+
+	count := 1
+	if p.MinCount > 1 {
+		st.matches = append(st.matches, now)
+		cutoff := now.Add(-p.Window)
+		for len(st.matches) > 0 && p.Window > 0 && st.matches[0].Before(cutoff) {
+			st.matches = st.matches[1:]
+		}
+		if len(st.matches) < p.MinCount {
+			return nil
+		}
+		count = len(st.matches)
+		st.matches = nil
+	}
+
+	if p.RateLimit > 0 && !st.lastEvent.IsZero() && now.Sub(st.lastEvent) < p.RateLimit {
+		st.suppressed++
+		return nil
+	}
+	st.lastEvent = now
+
+	evt := event.NewEvent(event.EventTypeLogMatch, m.containerID, m.containerName, m.imageName)
+	if p.MinCount > 1 {
+		evt.Message = fmt.Sprintf("Container %s log pattern %s matched %d times within %s",
+			m.containerName, p.Name, count, p.Window)
+	} else {
+		evt.Message = fmt.Sprintf("Container %s log pattern %s matched: %s", m.containerName, p.Name, line.Text)
+	}
+	evt.Severity = p.severity
+	payload := &event.LogMatchPayload{
+		Pattern:    p.Name,
+		Line:       line.Text,
+		Stream:     line.Stream,
+		MatchCount: count,
+		Suppressed: st.suppressed,
+	}
+	if p.MinCount > 1 {
+		payload.Window = p.Window
+	}
+	evt.SetPayload(payload)
+	st.suppressed = 0
+	return evt
+}
+
+// expire returns pending events whose deadline has passed, with the trailing context captured so far
+func (m *logMatcher) expire(now time.Time) []*event.Event {
+	var events []*event.Event
+	kept := m.pending[:0]
+	for _, p := range m.pending {
+		if now.After(p.deadline) {
+			events = append(events, p.finish())
+		} else {
+			kept = append(kept, p)
+		}
+	}
+	m.pending = kept
+	return events
+}
+
+// flush returns pending events with whatever trailing context was captured
+func (m *logMatcher) flush() []*event.Event {
+	var events []*event.Event
+	for _, p := range m.pending {
+		events = append(events, p.finish())
+	}
+	m.pending = nil
+	return events
+}
+
+// finish attaches the captured context to the event
+func (p *pendingMatch) finish() *event.Event {
+	if payload, ok := p.evt.Payload.(*event.LogMatchPayload); ok {
+		payload.Context = p.context
+		p.evt.SetPayload(payload)
+	}
+	return p.evt
+}
+
+// lastN returns the last n elements of lines
+func lastN(lines []string, n int) []string {
+	if n <= 0 {
+		return nil
+	}
+	if len(lines) <= n {
+		return lines
+	}
+	return lines[len(lines)-n:]
+}
@@ -0,0 +1,81 @@
+package monitor
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultMetricHistorySize is the default number of metric samples kept per container
+const DefaultMetricHistorySize = 60
+
+// MetricSample is a single metric sample of a container.
+// Rates are per second since the previous sample (0 for the first sample after start).
+type MetricSample struct {
+	Timestamp      time.Time
+	CPUPercent     float64
+	MemoryUsage    int64
+	MemoryPercent  float64
+	NetworkRxRate  float64 // Bytes/s
+	NetworkTxRate  float64 // Bytes/s
+	BlockReadRate  float64 // Bytes/s
+	BlockWriteRate float64 // Bytes/s
+}
+
+// MetricRing keeps the most recent metric samples of a container (ring buffer)
+type MetricRing struct {
+	mu      sync.RWMutex
+	samples []MetricSample
+	next    int // Index of the next write once the buffer is full
+	size    int
+}
+
+// NewMetricRing creates a new ring buffer holding at most size samples
+func NewMetricRing(size int) *MetricRing {
+	if size <= 0 {
+		size = DefaultMetricHistorySize
+	}
+	return &MetricRing{
+		samples: make([]MetricSample, 0, size),
+		size:    size,
+	}
+}
+
+// Add appends a sample, evicting the oldest one if the buffer is full
+func (r *MetricRing) Add(sample MetricSample) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.samples) < r.size {
+		r.samples = append(r.samples, sample)
+		return
+	}
+	r.samples[r.next] = sample
+	r.next = (r.next + 1) % r.size
+}
+
+// Samples returns a copy of the samples, oldest first
+func (r *MetricRing) Samples() []MetricSample {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]MetricSample, 0, len(r.samples))
+	result = append(result, r.samples[r.next:]...)
+	return append(result, r.samples[:r.next]...)
+}
+
+// Len returns the number of samples in the buffer
+func (r *MetricRing) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.samples)
+}
+
+// counterRate returns the per-second rate of a cumulative counter.
+// Returns 0 if the counter was reset (e.g. the container restarted).
+func counterRate(previous, current int64, elapsed time.Duration) float64 {
+	if elapsed <= 0 || current < previous {
+		return 0
+	}
+	return float64(current-previous) / elapsed.Seconds()
+}
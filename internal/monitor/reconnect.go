@@ -0,0 +1,117 @@
+package monitor
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"docksphinx/internal/docker"
+	"docksphinx/internal/event"
+)
+
+// StateUnknown is the state of tracked containers while the Docker daemon is unreachable
+const StateUnknown = "unknown"
+
+// maxReconnectBackoff bounds the time between collections while the Docker daemon is unreachable
+const maxReconnectBackoff = time.Minute
+
+// dockerConn tracks an outage of the Docker daemon. Only used from monitorLoop.
+type dockerConn struct {
+	down      bool
+	since     time.Time // When the outage was detected
+	attempts  int       // Failed collections during the outage
+	backoff   time.Duration
+	retryAt   time.Time
+	lastKnown map[string]string // Key: ContainerID, value: state before the outage
+}
+
+// waitingForDocker reports whether a collection should be skipped to back off from an unreachable daemon
+func (e *Engine) waitingForDocker(now time.Time) bool {
+	return e.conn.down && now.Before(e.conn.retryAt)
+}
+
+// listFailed handles a failed container listing. During an outage every error backs off,
+// since a restarting daemon also fails with e.g. EOF, 5xx responses or timeouts.
+func (e *Engine) listFailed(err error, now time.Time) {
+	if e.conn.down || errors.Is(err, docker.ErrDockerNotRunning) {
+		e.dockerDisconnected(err, now)
+		return
+	}
+	fmt.Printf("Error listing containers: %v\n", err)
+}
+
+// dockerDisconnected handles a collection that failed because the Docker daemon is unreachable.
+// The first failure marks all containers unknown and publishes docker_disconnected;
+// later failures double the backoff up to maxReconnectBackoff.
+func (e *Engine) dockerDisconnected(err error, now time.Time) {
+	c := &e.conn
+	c.attempts++
+	if c.down {
+		c.backoff = min(2*c.backoff, maxReconnectBackoff)
+		c.retryAt = now.Add(c.backoff)
+		return
+	}
+
+	c.down = true
+	c.since = now
+	c.backoff = e.config.Interval
+	c.retryAt = now.Add(c.backoff)
+	c.lastKnown = e.markUnknown()
+	e.dockerDown.Store(true)
+	if e.logWatcher != nil {
+		// Log streams fail with the daemon; they are restarted by the first collection after reconnecting
+		e.logWatcher.Sync(e.ctx, nil)
+	}
+	fmt.Printf("Docker daemon disconnected: %v (retrying with backoff up to %s)\n", err, maxReconnectBackoff)
+
+	evt := event.NewEvent(event.EventTypeDockerDisconnected, "", "", "")
+	evt.Message = fmt.Sprintf("Docker daemon is unreachable, %d container(s) marked unknown", len(c.lastKnown))
+	evt.Severity = event.SeverityCritical
+	evt.SetPayload(&event.DockerConnectionPayload{Error: err.Error(), Containers: len(c.lastKnown)})
+	e.publish([]*event.Event{evt})
+}
+
+// dockerReconnected ends an outage before the first successful collection is processed.
+// States from before the outage are restored, so that the collection only generates
+// events for containers that actually changed while the daemon was unreachable.
+func (e *Engine) dockerReconnected(containers int, now time.Time) {
+	c := &e.conn
+	for id, state := range c.lastKnown {
+		if st, ok := e.stateManager.GetState(id); ok {
+			restored := *st
+			restored.State = state
+			e.stateManager.UpdateState(id, &restored)
+		}
+	}
+	downtime := now.Sub(c.since)
+	fmt.Printf("Docker daemon reconnected after %s\n", downtime.Round(time.Second))
+
+	evt := event.NewEvent(event.EventTypeDockerReconnected, "", "", "")
+	evt.Message = fmt.Sprintf("Docker daemon is reachable again after %s", downtime.Round(time.Second))
+	evt.Severity = event.SeverityInfo
+	evt.SetPayload(&event.DockerConnectionPayload{Downtime: downtime, Attempts: c.attempts, Containers: containers})
+	e.publish([]*event.Event{evt})
+
+	*c = dockerConn{}
+	e.dockerDown.Store(false)
+}
+
+// markUnknown replaces the state and metrics of all tracked containers, which would
+// otherwise look current while the daemon is unreachable. Returns the replaced states.
+func (e *Engine) markUnknown() map[string]string {
+	lastKnown := make(map[string]string)
+	for id, st := range e.stateManager.GetAllStates() {
+		lastKnown[id] = st.State
+		e.stateManager.UpdateState(id, &ContainerState{
+			ContainerID:   st.ContainerID,
+			ContainerName: st.ContainerName,
+			ImageName:     st.ImageName,
+			Labels:        st.Labels,
+			State:         StateUnknown,
+			Status:        "Docker daemon unreachable",
+			LastSeen:      st.LastSeen,
+			History:       st.History,
+		})
+	}
+	return lastKnown
+}
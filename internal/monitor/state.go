@@ -1,8 +1,12 @@
 package monitor
 
 import (
+	"maps"
 	"sync"
 	"time"
+
+	"docksphinx/internal/docker"
+	"docksphinx/internal/graph"
 )
 
 // ContainerState represents the current state of a container
@@ -11,6 +15,7 @@ type ContainerState struct {
 	ContainerID   string
 	ContainerName string
 	ImageName     string
+	Labels        map[string]string
 
 	// State information
 	State    string    // "running", "exited", "restarting", etc.
@@ -24,6 +29,11 @@ type ContainerState struct {
 	MemoryPercent float64
 	NetworkRx     int64
 	NetworkTx     int64
+	BlockRead     int64
+	BlockWrite    int64
+
+	// Recent metric samples, carried over between collections
+	History *MetricRing
 
 	// For threshold detection
 	CPUThresholdCount    int // Consecutive CPU threshold violations
@@ -35,10 +45,27 @@ type ContainerState struct {
 	PreviousMem   float64
 }
 
+// ResourceInventory holds the last collected images, networks and volumes
+type ResourceInventory struct {
+	Images      []docker.Image
+	Networks    []docker.Network
+	Volumes     []docker.Volume
+	CollectedAt time.Time // Zero until the first collection succeeds
+}
+
 // StateManager manages container states
 type StateManager struct {
-	mu     sync.RWMutex
-	states map[string]*ContainerState // Key: ContainerID
+	mu        sync.RWMutex
+	states    map[string]*ContainerState // Key: ContainerID
+	inventory ResourceInventory
+
+	// Disk usage is collected separately at a slow interval
+	diskUsage   *docker.DiskUsage
+	diskUsageAt time.Time
+
+	// Dependency graph is rebuilt together with the resource inventory
+	depGraph   *graph.Graph
+	depGraphAt time.Time
 }
 
 // NewStateManager creates a new state manager
@@ -105,10 +132,81 @@ func (sm *StateManager) GetAllStates() map[string]*ContainerState {
 	return result
 }
 
+// UpdateInventory replaces the image, network and volume inventory
+func (sm *StateManager) UpdateInventory(inventory ResourceInventory) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	sm.inventory = inventory
+}
+
+// GetInventory returns a copy of the current image, network and volume inventory, including their labels
+func (sm *StateManager) GetInventory() ResourceInventory {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	inventory := ResourceInventory{
+		Images:      append([]docker.Image(nil), sm.inventory.Images...),
+		Networks:    append([]docker.Network(nil), sm.inventory.Networks...),
+		Volumes:     append([]docker.Volume(nil), sm.inventory.Volumes...),
+		CollectedAt: sm.inventory.CollectedAt,
+	}
+	for i := range inventory.Networks {
+		inventory.Networks[i].Labels = maps.Clone(inventory.Networks[i].Labels)
+	}
+	for i := range inventory.Volumes {
+		inventory.Volumes[i].Labels = maps.Clone(inventory.Volumes[i].Labels)
+	}
+	return inventory
+}
+
+// UpdateDiskUsage replaces the last collected disk usage.
+// The given value must not be modified afterwards.
+func (sm *StateManager) UpdateDiskUsage(du *docker.DiskUsage, collectedAt time.Time) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	sm.diskUsage = du
+	sm.diskUsageAt = collectedAt
+}
+
+// GetDiskUsage returns the last collected disk usage and when it was collected.
+// Returns nil if disk usage has not been collected yet. The result must be treated as read-only.
+func (sm *StateManager) GetDiskUsage() (*docker.DiskUsage, time.Time) {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	return sm.diskUsage, sm.diskUsageAt
+}
+
+// UpdateDependencyGraph replaces the container dependency graph.
+// The given value must not be modified afterwards.
+func (sm *StateManager) UpdateDependencyGraph(g *graph.Graph, collectedAt time.Time) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	sm.depGraph = g
+	sm.depGraphAt = collectedAt
+}
+
+// GetDependencyGraph returns the last built dependency graph and when it was built.
+// Returns nil if the graph has not been built yet. The result must be treated as read-only.
+func (sm *StateManager) GetDependencyGraph() (*graph.Graph, time.Time) {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	return sm.depGraph, sm.depGraphAt
+}
+
 // Clear removes all states (useful for testing or reset)
 func (sm *StateManager) Clear() {
 	sm.mu.Lock()
 	defer sm.mu.Unlock()
 
 	sm.states = make(map[string]*ContainerState)
+	sm.inventory = ResourceInventory{}
+	sm.diskUsage = nil
+	sm.diskUsageAt = time.Time{}
+	sm.depGraph = nil
+	sm.depGraphAt = time.Time{}
 }
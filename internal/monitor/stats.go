@@ -0,0 +1,76 @@
+package monitor
+
+import (
+	"sync"
+	"time"
+
+	"docksphinx/internal/event"
+)
+
+// EventCountKey identifies an event counter
+type EventCountKey struct {
+	Type     event.EventType
+	Severity event.Severity
+}
+
+// EngineStats represents counters about the engine itself
+type EngineStats struct {
+	Collections          uint64        // Number of container collections
+	CollectionErrors     uint64        // Collections that failed to list containers
+	LastCollectAt        time.Time     // Start of the last collection
+	LastCollectDuration  time.Duration // Duration of the last collection
+	LastCollectError     error         // Error of the last collection, nil if it succeeded
+	TotalCollectDuration time.Duration // Sum of all collection durations
+	DroppedEvents        uint64        // Events dropped because the event channel was full
+	EventCounts          map[EventCountKey]uint64
+	MonitoredContainers  int // Containers seen in the last collection
+}
+
+// statsRecorder records EngineStats concurrently
+type statsRecorder struct {
+	mu    sync.Mutex
+	stats EngineStats
+}
+
+func newStatsRecorder() *statsRecorder {
+	return &statsRecorder{stats: EngineStats{EventCounts: make(map[EventCountKey]uint64)}}
+}
+
+func (r *statsRecorder) recordCollection(at time.Time, d time.Duration, containers int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stats.Collections++
+	r.stats.LastCollectAt = at
+	r.stats.LastCollectDuration = d
+	r.stats.LastCollectError = err
+	r.stats.TotalCollectDuration += d
+	if err != nil {
+		r.stats.CollectionErrors++
+		return
+	}
+	r.stats.MonitoredContainers = containers
+}
+
+func (r *statsRecorder) recordEvent(evt *event.Event, dropped bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stats.EventCounts[EventCountKey{Type: evt.Type, Severity: evt.Severity}]++
+	if dropped {
+		r.stats.DroppedEvents++
+	}
+}
+
+// snapshot returns a copy of the stats
+func (r *statsRecorder) snapshot() EngineStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats := r.stats
+	stats.EventCounts = make(map[EventCountKey]uint64, len(r.stats.EventCounts))
+	for k, v := range r.stats.EventCounts {
+		stats.EventCounts[k] = v
+	}
+	return stats
+}
@@ -3,6 +3,7 @@ package monitor
 import (
 	"fmt"
 
+	"docksphinx/internal/docker"
 	"docksphinx/internal/event"
 )
 
@@ -10,6 +11,7 @@ import (
 type ThresholdConfig struct {
 	CPU    CPUThresholdConfig
 	Memory MemoryThresholdConfig
+	Volume VolumeThresholdConfig
 }
 
 // CPUThresholdConfig represents CPU threshold configuration
@@ -26,6 +28,13 @@ type MemoryThresholdConfig struct {
 	ConsecutiveCount int     // Number of consecutive violations before generating event
 }
 
+// VolumeThresholdConfig represents volume size growth threshold configuration
+// Growth is measured between two consecutive disk usage collections; zero disables the check
+type VolumeThresholdConfig struct {
+	GrowthBytes   int64   // Growth threshold (bytes)
+	GrowthPercent float64 // Growth threshold relative to the previous size (%)
+}
+
 // DefaultThresholdConfig returns default threshold configuration
 func DefaultThresholdConfig() ThresholdConfig {
 	return ThresholdConfig{
@@ -70,10 +79,13 @@ func (tm *ThresholdMonitor) CheckThresholds(
 			evt := event.NewEvent(event.EventTypeCPUThreshold, containerID, containerName, imageName)
 			evt.Message = fmt.Sprintf("Container %s CPU usage critical: %.2f%% (threshold: %.2f%%)",
 				containerName, cpuPercent, tm.config.CPU.Critical)
-			evt.Data["cpu_percent"] = cpuPercent
-			evt.Data["threshold"] = tm.config.CPU.Critical
-			evt.Data["level"] = "critical"
-			evt.Data["consecutive_count"] = state.CPUThresholdCount
+			evt.Severity = event.SeverityCritical
+			evt.SetPayload(&event.ThresholdPayload{
+				Metric:           event.MetricCPU,
+				Value:            cpuPercent,
+				Threshold:        tm.config.CPU.Critical,
+				ConsecutiveCount: state.CPUThresholdCount,
+			})
 			events = append(events, evt)
 			state.CPUThresholdCount = 0
 		}
@@ -83,10 +95,13 @@ func (tm *ThresholdMonitor) CheckThresholds(
 			evt := event.NewEvent(event.EventTypeCPUThreshold, containerID, containerName, imageName)
 			evt.Message = fmt.Sprintf("Container %s CPU usage warning: %.2f%% (threshold: %.2f%%)",
 				containerName, cpuPercent, tm.config.CPU.Warning)
-			evt.Data["cpu_percent"] = cpuPercent
-			evt.Data["threshold"] = tm.config.CPU.Warning
-			evt.Data["level"] = "warning"
-			evt.Data["consecutive_count"] = state.CPUThresholdCount
+			evt.Severity = event.SeverityWarning
+			evt.SetPayload(&event.ThresholdPayload{
+				Metric:           event.MetricCPU,
+				Value:            cpuPercent,
+				Threshold:        tm.config.CPU.Warning,
+				ConsecutiveCount: state.CPUThresholdCount,
+			})
 			events = append(events, evt)
 			state.CPUThresholdCount = 0
 		}
@@ -101,10 +116,13 @@ func (tm *ThresholdMonitor) CheckThresholds(
 			evt := event.NewEvent(event.EventTypeMemThreshold, containerID, containerName, imageName)
 			evt.Message = fmt.Sprintf("Container %s memory usage critical: %.2f%% (threshold: %.2f%%)",
 				containerName, memoryPercent, tm.config.Memory.Critical)
-			evt.Data["memory_percent"] = memoryPercent
-			evt.Data["threshold"] = tm.config.Memory.Critical
-			evt.Data["level"] = "critical"
-			evt.Data["consecutive_count"] = state.MemoryThresholdCount
+			evt.Severity = event.SeverityCritical
+			evt.SetPayload(&event.ThresholdPayload{
+				Metric:           event.MetricMemory,
+				Value:            memoryPercent,
+				Threshold:        tm.config.Memory.Critical,
+				ConsecutiveCount: state.MemoryThresholdCount,
+			})
 			events = append(events, evt)
 			state.MemoryThresholdCount = 0
 		}
@@ -114,10 +132,13 @@ func (tm *ThresholdMonitor) CheckThresholds(
 			evt := event.NewEvent(event.EventTypeMemThreshold, containerID, containerName, imageName)
 			evt.Message = fmt.Sprintf("Container %s memory usage warning: %.2f%% (threshold: %.2f%%)",
 				containerName, memoryPercent, tm.config.Memory.Warning)
-			evt.Data["memory_percent"] = memoryPercent
-			evt.Data["threshold"] = tm.config.Memory.Warning
-			evt.Data["level"] = "warning"
-			evt.Data["consecutive_count"] = state.MemoryThresholdCount
+			evt.Severity = event.SeverityWarning
+			evt.SetPayload(&event.ThresholdPayload{
+				Metric:           event.MetricMemory,
+				Value:            memoryPercent,
+				Threshold:        tm.config.Memory.Warning,
+				ConsecutiveCount: state.MemoryThresholdCount,
+			})
 			events = append(events, evt)
 			state.MemoryThresholdCount = 0
 		}
@@ -127,3 +148,62 @@ func (tm *ThresholdMonitor) CheckThresholds(
 
 	return events
 }
+
+// CheckVolumeGrowth compares two disk usage collections and returns events
+// for volumes whose size grew by at least the configured threshold
+func (tm *ThresholdMonitor) CheckVolumeGrowth(previous, current *docker.DiskUsage) []*event.Event {
+	if previous == nil || current == nil {
+		return nil
+	}
+	if tm.config.Volume.GrowthBytes <= 0 && tm.config.Volume.GrowthPercent <= 0 {
+		return nil
+	}
+
+	previousSizes := make(map[string]int64, len(previous.Volumes))
+	for _, vol := range previous.Volumes {
+		previousSizes[vol.Name] = vol.Size
+	}
+
+	var events []*event.Event
+	for _, vol := range current.Volumes {
+		previousSize, ok := previousSizes[vol.Name]
+		// Size is -1 when the driver cannot report it
+		if !ok || previousSize < 0 || vol.Size < 0 {
+			continue
+		}
+
+		growth := vol.Size - previousSize
+		if growth <= 0 {
+			continue
+		}
+
+		growthPercent := 0.0
+		if previousSize > 0 {
+			growthPercent = float64(growth) / float64(previousSize) * 100.0
+		}
+
+		exceeded := tm.config.Volume.GrowthBytes > 0 && growth >= tm.config.Volume.GrowthBytes
+		if tm.config.Volume.GrowthPercent > 0 && previousSize > 0 && growthPercent >= tm.config.Volume.GrowthPercent {
+			exceeded = true
+		}
+		if !exceeded {
+			continue
+		}
+
+		evt := event.NewEvent(event.EventTypeVolumeGrowth, "", "", "")
+		evt.Message = fmt.Sprintf("Volume %s grew by %d bytes (%.2f%%): %d -> %d bytes",
+			vol.Name, growth, growthPercent, previousSize, vol.Size)
+		evt.Severity = event.SeverityWarning
+		evt.SetPayload(&event.VolumeGrowthPayload{
+			VolumeName:    vol.Name,
+			Size:          vol.Size,
+			PreviousSize:  previousSize,
+			GrowthBytes:   growth,
+			GrowthPercent: growthPercent,
+			RefCount:      vol.RefCount,
+		})
+		events = append(events, evt)
+	}
+
+	return events
+}
@@ -0,0 +1,418 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"docksphinx/internal/event"
+)
+
+// Chat formats
+const (
+	FormatSlack   = "slack"
+	FormatDiscord = "discord"
+	FormatTeams   = "teams"
+)
+
+// DefaultThreadWindow is how long a thread keeps receiving the events of a container
+const DefaultThreadWindow = time.Hour
+
+// slackPostMessageURL is the Slack Web API method used with a bot token
+const slackPostMessageURL = "https://slack.com/api/chat.postMessage"
+
+// ChatOptions configures a chat sink
+type ChatOptions struct {
+	Name    string // Used in logs and dead letters (default: the format)
+	Format  string // slack, discord or teams
+	URL     string // Incoming webhook URL (Slack: optional with Token)
+	Headers map[string]string
+	Timeout time.Duration // Request timeout (default: 10s)
+
+	// Slack only: a bot token posts with chat.postMessage to Channel instead of an incoming webhook
+	Token   string
+	Channel string
+
+	// Threads groups the events of a container (or compose service) into a thread, so that
+	// a flapping container doesn't flood the channel. Requires a Slack bot token or a
+	// Discord forum channel; not supported by Teams.
+	Threads      bool
+	ThreadWindow time.Duration // A new thread is started after this long without events (default: DefaultThreadWindow)
+}
+
+// ChatSink posts events as rich messages to Slack, Discord or Microsoft Teams
+type ChatSink struct {
+	opts   ChatOptions
+	format chatFormat
+	client *http.Client
+
+	mu      sync.Mutex
+	threads map[string]*thread // Key: threadKey
+}
+
+// thread is an open chat thread
+type thread struct {
+	id   string
+	last time.Time
+}
+
+// chatFormat renders messages and reads replies of a chat platform
+type chatFormat interface {
+	// body renders a message. threadID is empty for a message starting a new thread.
+	body(opts ChatOptions, m message, evt *event.Event, threadID string) interface{}
+	// url returns the request URL
+	url(opts ChatOptions, threadID string) string
+	// reply checks the response body and returns the ID of a thread started by the message
+	reply(opts ChatOptions, body []byte) (threadID string, err error)
+}
+
+// NewChatSink creates a chat sink
+func NewChatSink(opts ChatOptions) (*ChatSink, error) {
+	var format chatFormat
+	switch opts.Format {
+	case FormatSlack:
+		format = slackFormat{}
+		if opts.Token != "" {
+			if opts.Channel == "" {
+				return nil, fmt.Errorf("slack channel is required with a bot token")
+			}
+			if opts.URL == "" {
+				opts.URL = slackPostMessageURL
+			}
+		} else if opts.Threads {
+			return nil, fmt.Errorf("slack threads require a bot token (incoming webhooks cannot reply in threads)")
+		}
+	case FormatDiscord:
+		format = discordFormat{}
+	case FormatTeams:
+		format = teamsFormat{}
+		if opts.Threads {
+			return nil, fmt.Errorf("teams webhooks do not support threads")
+		}
+	default:
+		return nil, fmt.Errorf("unknown chat format %q", opts.Format)
+	}
+	if !strings.HasPrefix(opts.URL, "http://") && !strings.HasPrefix(opts.URL, "https://") {
+		return nil, fmt.Errorf("%s url must be an http(s) URL: %q", opts.Format, opts.URL)
+	}
+	if opts.Name == "" {
+		opts.Name = opts.Format
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	if opts.ThreadWindow <= 0 {
+		opts.ThreadWindow = DefaultThreadWindow
+	}
+	return &ChatSink{
+		opts:    opts,
+		format:  format,
+		client:  &http.Client{Timeout: opts.Timeout},
+		threads: make(map[string]*thread),
+	}, nil
+}
+
+// Name implements Sink
+func (s *ChatSink) Name() string {
+	return s.opts.Name
+}
+
+// Send implements Sink
+func (s *ChatSink) Send(ctx context.Context, evt *event.Event) error {
+	key := threadKey(evt)
+	now := time.Now()
+	threadID := ""
+	if s.opts.Threads {
+		threadID = s.thread(key, now)
+	}
+
+	body, err := json.Marshal(s.format.body(s.opts, newMessage(evt), evt, threadID))
+	if err != nil {
+		return &PermanentError{Err: err}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.format.url(s.opts, threadID), bytes.NewReader(body))
+	if err != nil {
+		return &PermanentError{Err: err}
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("User-Agent", "docksphinx")
+	if s.opts.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.opts.Token)
+	}
+	for k, v := range s.opts.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode/100 != 2 {
+		err := fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(respBody[:min(len(respBody), 512)])))
+		if resp.StatusCode/100 == 4 && resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+			return &PermanentError{Err: err}
+		}
+		return err
+	}
+
+	started, err := s.format.reply(s.opts, respBody)
+	if err != nil {
+		return err
+	}
+	if s.opts.Threads {
+		s.touch(key, threadID, started, now)
+	}
+	return nil
+}
+
+// thread returns the ID of the open thread of key, or "" to start a new one
+func (s *ChatSink) thread(key string, now time.Time) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.threads[key]
+	if !ok || now.Sub(t.last) > s.opts.ThreadWindow {
+		return ""
+	}
+	return t.id
+}
+
+// touch records activity in the thread of key, remembering a newly started thread
+func (s *ChatSink) touch(key, threadID, started string, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for k, t := range s.threads {
+		if now.Sub(t.last) > s.opts.ThreadWindow {
+			delete(s.threads, k)
+		}
+	}
+	if threadID == "" {
+		threadID = started
+	}
+	if threadID != "" {
+		s.threads[key] = &thread{id: threadID, last: now}
+	}
+}
+
+// slackFormat renders Slack messages (incoming webhook or chat.postMessage)
+type slackFormat struct{}
+
+func (slackFormat) body(opts ChatOptions, m message, evt *event.Event, threadID string) interface{} {
+	type slackField struct {
+		Title string `json:"title"`
+		Value string `json:"value"`
+		Short bool   `json:"short"`
+	}
+	type slackAttachment struct {
+		Color     string       `json:"color"`
+		Fallback  string       `json:"fallback"`
+		Title     string       `json:"title"`
+		TitleLink string       `json:"title_link,omitempty"`
+		Text      string       `json:"text"`
+		Fields    []slackField `json:"fields,omitempty"`
+		Footer    string       `json:"footer"`
+		Ts        int64        `json:"ts"`
+	}
+	text := m.Text
+	if m.Logs != "" {
+		text += "\n```" + m.Logs + "```"
+	}
+	att := slackAttachment{
+		Color:     fmt.Sprintf("#%06X", m.Color),
+		Fallback:  m.Title + ": " + m.Text,
+		Title:     m.Title,
+		TitleLink: m.Runbook,
+		Text:      text,
+		Footer:    "docksphinx",
+		Ts:        evt.Timestamp.Unix(),
+	}
+	for _, f := range m.Fields {
+		att.Fields = append(att.Fields, slackField{Title: f.Name, Value: f.Value, Short: f.Short})
+	}
+	return struct {
+		Channel     string            `json:"channel,omitempty"`
+		Text        string            `json:"text"`
+		ThreadTS    string            `json:"thread_ts,omitempty"`
+		Attachments []slackAttachment `json:"attachments"`
+	}{Channel: opts.Channel, Text: m.Title, ThreadTS: threadID, Attachments: []slackAttachment{att}}
+}
+
+func (slackFormat) url(opts ChatOptions, threadID string) string {
+	return opts.URL
+}
+
+func (slackFormat) reply(opts ChatOptions, body []byte) (string, error) {
+	if opts.Token == "" {
+		// Incoming webhooks reply with plain text
+		return "", nil
+	}
+	var resp struct {
+		OK    bool   `json:"ok"`
+		TS    string `json:"ts"`
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", fmt.Errorf("slack: invalid response: %w", err)
+	}
+	if !resp.OK {
+		err := fmt.Errorf("slack: %s", resp.Error)
+		if resp.Error == "ratelimited" {
+			return "", err
+		}
+		return "", &PermanentError{Err: err}
+	}
+	return resp.TS, nil
+}
+
+// discordFormat renders Discord webhook messages. Threads are forum posts.
+type discordFormat struct{}
+
+func (discordFormat) body(opts ChatOptions, m message, evt *event.Event, threadID string) interface{} {
+	type discordField struct {
+		Name   string `json:"name"`
+		Value  string `json:"value"`
+		Inline bool   `json:"inline"`
+	}
+	type discordEmbed struct {
+		Title       string         `json:"title"`
+		URL         string         `json:"url,omitempty"`
+		Description string         `json:"description"`
+		Color       int            `json:"color"`
+		Fields      []discordField `json:"fields,omitempty"`
+		Timestamp   string         `json:"timestamp"`
+		Footer      struct {
+			Text string `json:"text"`
+		} `json:"footer"`
+	}
+	description := m.Text
+	if m.Logs != "" {
+		description += "\n```\n" + m.Logs + "\n```"
+	}
+	embed := discordEmbed{
+		Title:       m.Title,
+		URL:         m.Runbook,
+		Description: description,
+		Color:       m.Color,
+		Timestamp:   evt.Timestamp.UTC().Format(time.RFC3339),
+	}
+	embed.Footer.Text = "docksphinx"
+	for _, f := range m.Fields {
+		embed.Fields = append(embed.Fields, discordField{Name: f.Name, Value: f.Value, Inline: f.Short})
+	}
+	msg := struct {
+		Username   string         `json:"username"`
+		ThreadName string         `json:"thread_name,omitempty"`
+		Embeds     []discordEmbed `json:"embeds"`
+	}{Username: "docksphinx", Embeds: []discordEmbed{embed}}
+	if opts.Threads && threadID == "" {
+		msg.ThreadName = threadKey(evt)
+	}
+	return msg
+}
+
+func (discordFormat) url(opts ChatOptions, threadID string) string {
+	q := url.Values{}
+	// wait=true makes Discord reply with the created message, including its thread
+	q.Set("wait", "true")
+	if threadID != "" {
+		q.Set("thread_id", threadID)
+	}
+	sep := "?"
+	if strings.Contains(opts.URL, "?") {
+		sep = "&"
+	}
+	return opts.URL + sep + q.Encode()
+}
+
+func (discordFormat) reply(opts ChatOptions, body []byte) (string, error) {
+	if !opts.Threads {
+		return "", nil
+	}
+	var resp struct {
+		ChannelID string `json:"channel_id"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", fmt.Errorf("discord: invalid response: %w", err)
+	}
+	return resp.ChannelID, nil
+}
+
+// teamsFormat renders Microsoft Teams connector cards
+type teamsFormat struct{}
+
+func (teamsFormat) body(opts ChatOptions, m message, evt *event.Event, threadID string) interface{} {
+	type teamsFact struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	type teamsSection struct {
+		ActivityTitle    string      `json:"activityTitle,omitempty"`
+		ActivitySubtitle string      `json:"activitySubtitle,omitempty"`
+		Text             string      `json:"text,omitempty"`
+		Facts            []teamsFact `json:"facts,omitempty"`
+	}
+	type teamsTarget struct {
+		OS  string `json:"os"`
+		URI string `json:"uri"`
+	}
+	type teamsAction struct {
+		Type    string        `json:"@type"`
+		Name    string        `json:"name"`
+		Targets []teamsTarget `json:"targets"`
+	}
+
+	section := teamsSection{
+		ActivityTitle:    m.Text,
+		ActivitySubtitle: evt.Timestamp.Format(time.RFC3339),
+	}
+	for _, f := range m.Fields {
+		section.Facts = append(section.Facts, teamsFact{Name: f.Name, Value: f.Value})
+	}
+	sections := []teamsSection{section}
+	if m.Logs != "" {
+		sections = append(sections, teamsSection{Text: "<pre>" + m.Logs + "</pre>"})
+	}
+
+	card := struct {
+		Type            string         `json:"@type"`
+		Context         string         `json:"@context"`
+		ThemeColor      string         `json:"themeColor"`
+		Summary         string         `json:"summary"`
+		Title           string         `json:"title"`
+		Sections        []teamsSection `json:"sections"`
+		PotentialAction []teamsAction  `json:"potentialAction,omitempty"`
+	}{
+		Type:       "MessageCard",
+		Context:    "https://schema.org/extensions",
+		ThemeColor: fmt.Sprintf("%06X", m.Color),
+		Summary:    m.Title,
+		Title:      m.Title,
+		Sections:   sections,
+	}
+	if m.Runbook != "" {
+		card.PotentialAction = []teamsAction{{
+			Type:    "OpenUri",
+			Name:    "Open runbook",
+			Targets: []teamsTarget{{OS: "default", URI: m.Runbook}},
+		}}
+	}
+	return card
+}
+
+func (teamsFormat) url(opts ChatOptions, threadID string) string {
+	return opts.URL
+}
+
+func (teamsFormat) reply(opts ChatOptions, body []byte) (string, error) {
+	return "", nil
+}
@@ -0,0 +1,235 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"docksphinx/internal/event"
+)
+
+// Default desktop notification settings
+const (
+	DefaultDesktopRateLimit = 6 // Notifications per minute
+	DefaultSnoozeDuration   = 30 * time.Minute
+)
+
+// actionSnooze is the action key of the snooze button
+const actionSnooze = "snooze"
+
+// maxDesktopNotifications bounds the notifications remembered for their actions
+const maxDesktopNotifications = 256
+
+// Urgency is the urgency of a desktop notification
+type Urgency byte
+
+const (
+	UrgencyLow Urgency = iota
+	UrgencyNormal
+	UrgencyCritical
+)
+
+// DesktopNotification is a notification shown by a DesktopBackend
+type DesktopNotification struct {
+	Summary string
+	Body    string
+	Urgency Urgency
+	Actions []DesktopAction // Buttons, if supported by the backend
+}
+
+// DesktopAction is a button of a notification
+type DesktopAction struct {
+	Key   string
+	Label string
+}
+
+// ActionInvoked reports that the user clicked an action of a notification
+type ActionInvoked struct {
+	ID  uint32 // As returned by DesktopBackend.Notify
+	Key string
+}
+
+// DesktopBackend shows native desktop notifications
+type DesktopBackend interface {
+	// Notify shows a notification and returns its ID
+	Notify(n DesktopNotification) (uint32, error)
+	// Actions returns invoked actions, or nil if the backend does not support actions
+	Actions() <-chan ActionInvoked
+	Close() error
+}
+
+// DesktopOptions configures a desktop sink
+type DesktopOptions struct {
+	RateLimit int           // Notifications per minute; further events are counted and summarized (default: DefaultDesktopRateLimit)
+	Snooze    time.Duration // Duration of the snooze action (default: DefaultSnoozeDuration)
+}
+
+// DesktopSink raises desktop notifications. Containers can be snoozed from a notification action.
+type DesktopSink struct {
+	opts    DesktopOptions
+	backend DesktopBackend
+
+	mu         sync.Mutex
+	sent       []time.Time          // Notifications within the last minute
+	suppressed int                  // Events dropped by the rate limit since the last notification
+	snoozed    map[string]time.Time // Key: container name, value: end of the snooze
+	ids        map[uint32]string    // Notification ID -> container name
+	order      []uint32             // Notification IDs, oldest first
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewDesktopSink creates a desktop sink showing notifications with backend
+func NewDesktopSink(opts DesktopOptions, backend DesktopBackend) *DesktopSink {
+	if opts.RateLimit <= 0 {
+		opts.RateLimit = DefaultDesktopRateLimit
+	}
+	if opts.Snooze <= 0 {
+		opts.Snooze = DefaultSnoozeDuration
+	}
+	s := &DesktopSink{
+		opts:    opts,
+		backend: backend,
+		snoozed: make(map[string]time.Time),
+		ids:     make(map[uint32]string),
+		done:    make(chan struct{}),
+	}
+	if actions := backend.Actions(); actions != nil {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handleActions(actions)
+		}()
+	}
+	return s
+}
+
+// Name implements Sink
+func (s *DesktopSink) Name() string {
+	return "desktop"
+}
+
+// Send implements Sink. Events of snoozed containers, summary alerts whose containers are all
+// snoozed, and events over the rate limit are skipped.
+func (s *DesktopSink) Send(ctx context.Context, evt *event.Event) error {
+	now := time.Now()
+
+	s.mu.Lock()
+	if s.allSnoozed(containerNames(evt), now) {
+		s.mu.Unlock()
+		return nil
+	}
+	for len(s.sent) > 0 && now.Sub(s.sent[0]) >= time.Minute {
+		s.sent = s.sent[1:]
+	}
+	if len(s.sent) >= s.opts.RateLimit {
+		s.suppressed++
+		s.mu.Unlock()
+		return nil
+	}
+	suppressed := s.suppressed
+	s.mu.Unlock()
+
+	m := newMessage(evt)
+	n := DesktopNotification{
+		Summary: m.Title,
+		Body:    m.Text,
+		Urgency: UrgencyNormal,
+	}
+	if evt.ContainerName != "" {
+		n.Actions = []DesktopAction{{Key: actionSnooze, Label: fmt.Sprintf("Snooze %s for %s", evt.ContainerName, formatMinutes(s.opts.Snooze))}}
+	}
+	switch m.Severity {
+	case event.SeverityCritical:
+		n.Urgency = UrgencyCritical
+	case event.SeverityInfo:
+		n.Urgency = UrgencyLow
+	}
+	if suppressed > 0 {
+		n.Body += fmt.Sprintf("\n(%d more events suppressed)", suppressed)
+	}
+
+	id, err := s.backend.Notify(n)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, now)
+	s.suppressed -= suppressed
+	if evt.ContainerName != "" {
+		s.ids[id] = evt.ContainerName
+		s.order = append(s.order, id)
+		if len(s.order) > maxDesktopNotifications {
+			delete(s.ids, s.order[0])
+			s.order = s.order[1:]
+		}
+	}
+	return nil
+}
+
+// allSnoozed reports whether all the given containers are snoozed, dropping expired snoozes. Requires s.mu.
+func (s *DesktopSink) allSnoozed(names []string, now time.Time) bool {
+	if len(names) == 0 {
+		return false
+	}
+	all := true
+	for _, name := range names {
+		until, ok := s.snoozed[name]
+		if ok && !now.Before(until) {
+			delete(s.snoozed, name)
+			ok = false
+		}
+		all = all && ok
+	}
+	return all
+}
+
+// Snooze suppresses notifications of a container for the given duration
+func (s *DesktopSink) Snooze(containerName string, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snoozed[containerName] = time.Now().Add(d)
+}
+
+// handleActions snoozes containers when their snooze action is invoked
+func (s *DesktopSink) handleActions(actions <-chan ActionInvoked) {
+	for {
+		select {
+		case <-s.done:
+			return
+		case a, ok := <-actions:
+			if !ok {
+				return
+			}
+			if a.Key != actionSnooze {
+				continue
+			}
+			s.mu.Lock()
+			name, found := s.ids[a.ID]
+			s.mu.Unlock()
+			if found {
+				s.Snooze(name, s.opts.Snooze)
+			}
+		}
+	}
+}
+
+// Close stops handling actions and closes the backend
+func (s *DesktopSink) Close() error {
+	close(s.done)
+	err := s.backend.Close()
+	s.wg.Wait()
+	return err
+}
+
+// formatMinutes formats a duration as minutes or hours for action labels
+func formatMinutes(d time.Duration) string {
+	if d >= time.Hour && d%time.Hour == 0 {
+		return fmt.Sprintf("%dh", int(d/time.Hour))
+	}
+	return fmt.Sprintf("%d min", int(d.Round(time.Minute)/time.Minute))
+}
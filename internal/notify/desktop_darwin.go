@@ -0,0 +1,41 @@
+package notify
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+)
+
+// osascriptBackend shows notifications with AppleScript. Actions are not supported.
+type osascriptBackend struct {
+	next uint32
+}
+
+// DefaultDesktopBackend returns the desktop notification backend of the platform
+// (Notification Center through osascript on macOS)
+func DefaultDesktopBackend() (DesktopBackend, error) {
+	if _, err := exec.LookPath("osascript"); err != nil {
+		return nil, fmt.Errorf("desktop notifications: %w", err)
+	}
+	return &osascriptBackend{}, nil
+}
+
+// Notify implements DesktopBackend
+func (b *osascriptBackend) Notify(n DesktopNotification) (uint32, error) {
+	script := fmt.Sprintf("display notification %s with title %s", strconv.Quote(n.Body), strconv.Quote(n.Summary))
+	if out, err := exec.Command("osascript", "-e", script).CombinedOutput(); err != nil {
+		return 0, fmt.Errorf("desktop notification: %w: %s", err, out)
+	}
+	b.next++
+	return b.next, nil
+}
+
+// Actions implements DesktopBackend
+func (b *osascriptBackend) Actions() <-chan ActionInvoked {
+	return nil
+}
+
+// Close implements DesktopBackend
+func (b *osascriptBackend) Close() error {
+	return nil
+}
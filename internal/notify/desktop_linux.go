@@ -0,0 +1,94 @@
+package notify
+
+import (
+	"fmt"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	dbusNotificationsName  = "org.freedesktop.Notifications"
+	dbusNotificationsPath  = "/org/freedesktop/Notifications"
+	dbusNotificationsIface = "org.freedesktop.Notifications"
+)
+
+// dbusBackend shows notifications through the freedesktop notification service on the session bus
+type dbusBackend struct {
+	conn    *dbus.Conn
+	obj     dbus.BusObject
+	signals chan *dbus.Signal
+	actions chan ActionInvoked
+}
+
+// DefaultDesktopBackend returns the desktop notification backend of the platform
+// (the freedesktop org.freedesktop.Notifications D-Bus service on Linux)
+func DefaultDesktopBackend() (DesktopBackend, error) {
+	conn, err := dbus.ConnectSessionBus()
+	if err != nil {
+		return nil, fmt.Errorf("connect to session bus: %w", err)
+	}
+	if err := conn.AddMatchSignal(
+		dbus.WithMatchObjectPath(dbusNotificationsPath),
+		dbus.WithMatchInterface(dbusNotificationsIface),
+		dbus.WithMatchMember("ActionInvoked"),
+	); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("subscribe to notification actions: %w", err)
+	}
+
+	b := &dbusBackend{
+		conn:    conn,
+		obj:     conn.Object(dbusNotificationsName, dbusNotificationsPath),
+		signals: make(chan *dbus.Signal, 16),
+		actions: make(chan ActionInvoked, 16),
+	}
+	conn.Signal(b.signals)
+	go b.forwardActions()
+	return b, nil
+}
+
+// forwardActions converts ActionInvoked signals until the connection is closed
+func (b *dbusBackend) forwardActions() {
+	defer close(b.actions)
+	for sig := range b.signals {
+		if sig.Name != dbusNotificationsIface+".ActionInvoked" || len(sig.Body) != 2 {
+			continue
+		}
+		id, ok1 := sig.Body[0].(uint32)
+		key, ok2 := sig.Body[1].(string)
+		if !ok1 || !ok2 {
+			continue
+		}
+		select {
+		case b.actions <- ActionInvoked{ID: id, Key: key}:
+		default:
+		}
+	}
+}
+
+// Notify implements DesktopBackend
+func (b *dbusBackend) Notify(n DesktopNotification) (uint32, error) {
+	actions := make([]string, 0, 2*len(n.Actions))
+	for _, a := range n.Actions {
+		actions = append(actions, a.Key, a.Label)
+	}
+	hints := map[string]dbus.Variant{"urgency": dbus.MakeVariant(byte(n.Urgency))}
+
+	var id uint32
+	call := b.obj.Call(dbusNotificationsIface+".Notify", 0,
+		"docksphinx", uint32(0), "", n.Summary, n.Body, actions, hints, int32(-1))
+	if err := call.Store(&id); err != nil {
+		return 0, fmt.Errorf("desktop notification: %w", err)
+	}
+	return id, nil
+}
+
+// Actions implements DesktopBackend
+func (b *dbusBackend) Actions() <-chan ActionInvoked {
+	return b.actions
+}
+
+// Close implements DesktopBackend. Closing the connection also closes the signal channel.
+func (b *dbusBackend) Close() error {
+	return b.conn.Close()
+}
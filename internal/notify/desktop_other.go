@@ -0,0 +1,14 @@
+//go:build !linux && !darwin
+
+package notify
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// DefaultDesktopBackend returns the desktop notification backend of the platform.
+// There is none on this platform; pass a custom DesktopBackend to NewDesktopSink instead.
+func DefaultDesktopBackend() (DesktopBackend, error) {
+	return nil, fmt.Errorf("desktop notifications are not supported on %s", runtime.GOOS)
+}
@@ -0,0 +1,147 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+
+	"docksphinx/internal/event"
+	"docksphinx/internal/graph"
+)
+
+// LabelRunbook is the container label holding a runbook URL linked from chat messages
+const LabelRunbook = "docksphinx.runbook"
+
+// maxMessageLogLines bounds the captured log lines included in chat messages
+const maxMessageLogLines = 10
+
+// message is the platform-independent content of a chat message
+type message struct {
+	Title    string
+	Text     string
+	Severity event.Severity
+	Color    int // RGB
+	Fields   []field
+	Logs     string // Last captured log lines
+	Runbook  string // Empty if the container has no runbook label
+}
+
+// field is a labelled value shown in a chat message
+type field struct {
+	Name  string
+	Value string
+	Short bool // May be shown side by side
+}
+
+// Colors by severity
+var severityColors = map[event.Severity]int{
+	event.SeverityCritical: 0xD32F2F,
+	event.SeverityWarning:  0xF9A825,
+	event.SeverityInfo:     0x1976D2,
+}
+
+// newMessage renders an event into the content of a chat message
+func newMessage(evt *event.Event) message {
+	m := message{
+		Title:    fmt.Sprintf("[%s] %s: %s", strings.ToUpper(evt.Severity.String()), subject(evt), evt.Type),
+		Text:     evt.Message,
+		Severity: evt.Severity,
+		Color:    severityColors[evt.Severity],
+		Runbook:  evt.Labels[LabelRunbook],
+	}
+	if m.Text == "" {
+		m.Text = fmt.Sprintf("Container %s: %s", subject(evt), evt.Type)
+	}
+
+	if p, ok := evt.Payload.(*event.GroupPayload); ok {
+		m.Fields = append(m.Fields, field{Name: "Group", Value: p.Group, Short: true})
+		m.Fields = append(m.Fields, field{Name: "Containers", Value: strings.Join(p.Containers, ","), Short: true})
+	} else {
+		m.Fields = append(m.Fields, field{Name: "Container", Value: evt.ContainerName, Short: true})
+	}
+	if evt.ImageName != "" {
+		m.Fields = append(m.Fields, field{Name: "Image", Value: evt.ImageName, Short: true})
+	}
+	if project := evt.Labels[graph.LabelComposeProject]; project != "" {
+		m.Fields = append(m.Fields, field{Name: "Compose", Value: project + "/" + evt.Labels[graph.LabelComposeService], Short: true})
+	}
+	if value := thresholdValue(evt); value != "" {
+		m.Fields = append(m.Fields, field{Name: "Value", Value: value, Short: true})
+	}
+	switch p := evt.Payload.(type) {
+	case *event.StateChangePayload:
+		if len(p.Dependents) > 0 {
+			m.Fields = append(m.Fields, field{Name: "Dependents", Value: strings.Join(p.Dependents, ",")})
+		}
+	case *event.ImpactPayload:
+		if len(p.Affected) > 0 {
+			m.Fields = append(m.Fields, field{Name: "Affected", Value: strings.Join(p.Affected, ",")})
+		}
+	}
+	if m.Runbook != "" {
+		m.Fields = append(m.Fields, field{Name: "Runbook", Value: m.Runbook})
+	}
+
+	logs := evt.Logs
+	if len(logs) > maxMessageLogLines {
+		logs = logs[len(logs)-maxMessageLogLines:]
+	}
+	lines := make([]string, 0, len(logs))
+	for _, l := range logs {
+		lines = append(lines, l.Text)
+	}
+	m.Logs = strings.Join(lines, "\n")
+	return m
+}
+
+// thresholdValue describes the measured value against its limit for threshold events
+func thresholdValue(evt *event.Event) string {
+	switch p := evt.Payload.(type) {
+	case *event.ThresholdPayload:
+		name := "CPU"
+		if p.Metric == event.MetricMemory {
+			name = "Memory"
+		}
+		return fmt.Sprintf("%s %.1f%% (limit %.1f%%)", name, p.Value, p.Threshold)
+	case *event.VolumeGrowthPayload:
+		return fmt.Sprintf("+%s (%.1f%%) to %s", formatBytes(p.GrowthBytes), p.GrowthPercent, formatBytes(p.Size))
+	}
+	return ""
+}
+
+// formatBytes formats a byte count in human-readable units
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// threadKey identifies the thread of an event: the compose service, or the container name
+func threadKey(evt *event.Event) string {
+	if service := evt.Labels[graph.LabelComposeService]; service != "" {
+		return evt.Labels[graph.LabelComposeProject] + "/" + service
+	}
+	return subject(evt)
+}
+
+// subject names what an event is about: the container, or the group of a summary alert
+func subject(evt *event.Event) string {
+	if p, ok := evt.Payload.(*event.GroupPayload); ok && evt.ContainerName == "" {
+		return p.Group
+	}
+	return evt.ContainerName
+}
+
+// containerNames returns the container of an event, or the containers of a summary alert
+func containerNames(evt *event.Event) []string {
+	if p, ok := evt.Payload.(*event.GroupPayload); ok && evt.ContainerName == "" {
+		return p.Containers
+	}
+	return []string{evt.ContainerName}
+}
@@ -0,0 +1,253 @@
+// Package notify delivers docksphinx events to external notification sinks (e.g. webhooks).
+//
+// A Notifier subscribes to engine events and dispatches matching events to a queue per sink.
+// Failed deliveries are retried with exponential backoff; events that cannot be delivered
+// are appended to a dead-letter file.
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+
+	"docksphinx/internal/event"
+)
+
+// Default delivery settings
+const (
+	DefaultQueueSize      = 256
+	DefaultMaxAttempts    = 5
+	DefaultInitialBackoff = time.Second
+	DefaultMaxBackoff     = time.Minute
+)
+
+// EventSource provides event subscriptions (implemented by the gRPC server)
+type EventSource interface {
+	Subscribe() (<-chan *event.Event, func())
+}
+
+// Sink delivers a single event to an external system
+type Sink interface {
+	Name() string
+	Send(ctx context.Context, evt *event.Event) error
+}
+
+// PermanentError marks a delivery failure that is not retried (e.g. HTTP 400)
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string { return e.Err.Error() }
+func (e *PermanentError) Unwrap() error { return e.Err }
+
+// Filter selects the events delivered to a sink. Empty fields match everything.
+type Filter struct {
+	EventTypes  []event.EventType
+	Containers  *regexp.Regexp // Matched against the container name, or any container of a summary alert
+	MinSeverity event.Severity
+}
+
+// Match reports whether the event passes the filter
+func (f Filter) Match(evt *event.Event) bool {
+	if len(f.EventTypes) > 0 {
+		found := false
+		for _, t := range f.EventTypes {
+			if t == evt.Type {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.Containers != nil && !slices.ContainsFunc(containerNames(evt), f.Containers.MatchString) {
+		return false
+	}
+	if evt.Severity < f.MinSeverity {
+		return false
+	}
+	return true
+}
+
+// RetryPolicy configures redelivery of failed events (0: default)
+type RetryPolicy struct {
+	MaxAttempts    int // Including the first attempt
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// backoff returns the delay before the given retry (1 for the first retry)
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < retry && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, p.MaxBackoff)
+}
+
+// Route connects a sink to the events it receives
+type Route struct {
+	Sink      Sink
+	Filter    Filter
+	Retry     RetryPolicy
+	QueueSize int // Events waiting for delivery; further events are dead-lettered (0: DefaultQueueSize)
+}
+
+// Notifier dispatches events to sinks
+type Notifier struct {
+	source     EventSource
+	routes     []*route
+	deadLetter *DeadLetter // May be nil
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// route is a sink with its delivery queue
+type route struct {
+	Route
+	queue chan *event.Event
+}
+
+// NewNotifier creates a notifier. deadLetter may be nil to discard undeliverable events.
+func NewNotifier(source EventSource, routes []Route, deadLetter *DeadLetter) *Notifier {
+	ctx, cancel := context.WithCancel(context.Background())
+	n := &Notifier{source: source, deadLetter: deadLetter, ctx: ctx, cancel: cancel}
+	for _, r := range routes {
+		if r.QueueSize <= 0 {
+			r.QueueSize = DefaultQueueSize
+		}
+		if r.Retry.MaxAttempts <= 0 {
+			r.Retry.MaxAttempts = DefaultMaxAttempts
+		}
+		if r.Retry.InitialBackoff <= 0 {
+			r.Retry.InitialBackoff = DefaultInitialBackoff
+		}
+		if r.Retry.MaxBackoff <= 0 {
+			r.Retry.MaxBackoff = DefaultMaxBackoff
+		}
+		n.routes = append(n.routes, &route{Route: r, queue: make(chan *event.Event, r.QueueSize)})
+	}
+	return n
+}
+
+// Start starts dispatching in the background
+func (n *Notifier) Start() {
+	events, unsub := n.source.Subscribe()
+
+	for _, r := range n.routes {
+		n.wg.Add(1)
+		go func() {
+			defer n.wg.Done()
+			n.deliverLoop(r)
+		}()
+	}
+
+	n.wg.Add(1)
+	go func() {
+		defer n.wg.Done()
+		defer unsub()
+		for {
+			select {
+			case <-n.ctx.Done():
+				return
+			case evt, ok := <-events:
+				if !ok {
+					return
+				}
+				n.Dispatch(evt)
+			}
+		}
+	}()
+}
+
+// Stop stops dispatching. Events still queued or being retried are dead-lettered.
+// Sinks implementing io.Closer are closed.
+func (n *Notifier) Stop() {
+	n.cancel()
+	n.wg.Wait()
+	for _, r := range n.routes {
+		if c, ok := r.Sink.(io.Closer); ok {
+			if err := c.Close(); err != nil {
+				fmt.Printf("Error closing %s: %v\n", r.Sink.Name(), err)
+			}
+		}
+	}
+}
+
+// Dispatch queues the event for every sink whose filter matches it (non-blocking)
+func (n *Notifier) Dispatch(evt *event.Event) {
+	for _, r := range n.routes {
+		if !r.Filter.Match(evt) {
+			continue
+		}
+		select {
+		case r.queue <- evt:
+		default:
+			n.fail(r, evt, fmt.Errorf("queue full"))
+		}
+	}
+}
+
+// deliverLoop delivers queued events of a route until the notifier is stopped
+func (n *Notifier) deliverLoop(r *route) {
+	for {
+		select {
+		case <-n.ctx.Done():
+			for {
+				select {
+				case evt := <-r.queue:
+					n.fail(r, evt, n.ctx.Err())
+				default:
+					return
+				}
+			}
+		case evt := <-r.queue:
+			if err := n.deliver(r, evt); err != nil {
+				n.fail(r, evt, err)
+			}
+		}
+	}
+}
+
+// deliver sends the event, retrying with exponential backoff
+func (n *Notifier) deliver(r *route, evt *event.Event) error {
+	var err error
+	for attempt := 1; attempt <= r.Retry.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			timer := time.NewTimer(r.Retry.backoff(attempt - 1))
+			select {
+			case <-n.ctx.Done():
+				timer.Stop()
+				return fmt.Errorf("%w (last error: %v)", n.ctx.Err(), err)
+			case <-timer.C:
+			}
+		}
+		if err = r.Sink.Send(n.ctx, evt); err == nil {
+			return nil
+		}
+		var permanent *PermanentError
+		if errors.As(err, &permanent) || n.ctx.Err() != nil {
+			return err
+		}
+	}
+	return fmt.Errorf("giving up after %d attempts: %w", r.Retry.MaxAttempts, err)
+}
+
+// fail reports an undeliverable event and appends it to the dead-letter file
+func (n *Notifier) fail(r *route, evt *event.Event, err error) {
+	fmt.Printf("Error notifying %s of event %s: %v\n", r.Sink.Name(), evt.ID, err)
+	if n.deadLetter == nil {
+		return
+	}
+	if derr := n.deadLetter.Write(r.Sink.Name(), evt, err); derr != nil {
+		fmt.Printf("Error writing dead letter: %v\n", derr)
+	}
+}
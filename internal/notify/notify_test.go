@@ -0,0 +1,284 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"docksphinx/internal/event"
+)
+
+// fakeSource is an event source fed by the test
+type fakeSource struct {
+	ch chan *event.Event
+}
+
+func (s *fakeSource) Subscribe() (<-chan *event.Event, func()) {
+	return s.ch, func() {}
+}
+
+func TestFilter(t *testing.T) {
+	died := event.NewEvent(event.EventTypeDied, "abc", "api-1", "api:latest")
+	died.Severity = event.SeverityCritical
+	started := event.NewEvent(event.EventTypeStarted, "abc", "api-1", "api:latest")
+	cpu := event.NewEvent(event.EventTypeCPUThreshold, "def", "db", "postgres")
+	cpu.Severity = event.SeverityWarning
+
+	f := Filter{MinSeverity: event.SeverityWarning}
+	if !f.Match(died) || !f.Match(cpu) || f.Match(started) {
+		t.Error("Unexpected result of severity filter")
+	}
+	f = Filter{EventTypes: []event.EventType{event.EventTypeDied}}
+	if !f.Match(died) || f.Match(cpu) {
+		t.Error("Unexpected result of type filter")
+	}
+	f = Filter{Containers: regexp.MustCompile("^api")}
+	if !f.Match(started) || f.Match(cpu) {
+		t.Error("Unexpected result of container filter")
+	}
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	var mu sync.Mutex
+	var bodies []string
+	failures := 2
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.URL.Path == "/bad" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		if failures > 0 {
+			failures--
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, r.Header.Get("X-Token")+" "+string(body))
+	}))
+	defer srv.Close()
+
+	good, err := NewWebhookSink(WebhookOptions{
+		Name:         "good",
+		URL:          srv.URL + "/hook",
+		Headers:      map[string]string{"X-Token": "secret"},
+		BodyTemplate: `{"text": {{json .Message}}, "severity": "{{.Severity}}"}`,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create sink: %v", err)
+	}
+	bad, err := NewWebhookSink(WebhookOptions{Name: "bad", URL: srv.URL + "/bad"})
+	if err != nil {
+		t.Fatalf("Failed to create sink: %v", err)
+	}
+
+	retry := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+	deadLetter := NewDeadLetter(filepath.Join(t.TempDir(), "dead.jsonl"))
+	source := &fakeSource{ch: make(chan *event.Event, 4)}
+	n := NewNotifier(source, []Route{
+		{Sink: good, Filter: Filter{EventTypes: []event.EventType{event.EventTypeDied}}, Retry: retry},
+		{Sink: bad, Retry: retry},
+	}, deadLetter)
+	n.Start()
+
+	evt := event.NewEvent(event.EventTypeDied, "abc", "api", "api:latest")
+	evt.Message = `Container "api" died`
+	evt.Severity = event.SeverityCritical
+	source.ch <- evt
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		mu.Lock()
+		done := len(bodies) == 1
+		mu.Unlock()
+		if done {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for webhook delivery")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	n.Stop()
+
+	if want := `secret {"text": "Container \"api\" died", "severity": "critical"}`; bodies[0] != want {
+		t.Errorf("Unexpected request body:\n got %s\nwant %s", bodies[0], want)
+	}
+
+	// The bad sink fails permanently without retries
+	f, err := os.Open(deadLetter.Path())
+	if err != nil {
+		t.Fatalf("Expected dead-letter file: %v", err)
+	}
+	defer f.Close()
+	var records []deadLetterRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var rec deadLetterRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			t.Fatalf("Malformed dead letter: %v", err)
+		}
+		records = append(records, rec)
+	}
+	if len(records) != 1 || records[0].Sink != "bad" || records[0].Event.ID != evt.ID {
+		t.Errorf("Unexpected dead letters: %+v", records)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	for retry, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second, 10: 5 * time.Second} {
+		if got := p.backoff(retry); got != want {
+			t.Errorf("backoff(%d) = %s, want %s", retry, got, want)
+		}
+	}
+}
+
+func TestChatSinkThreads(t *testing.T) {
+	var mu sync.Mutex
+	var requests []map[string]interface{}
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		requests = append(requests, body)
+		queries = append(queries, r.URL.RawQuery)
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/slack":
+			w.Write([]byte(`{"ok": true, "ts": "1700000000.000100"}`))
+		case "/discord":
+			w.Write([]byte(`{"id": "1", "channel_id": "42"}`))
+		}
+	}))
+	defer srv.Close()
+
+	evt := event.NewEvent(event.EventTypeCPUThreshold, "abc", "shop-api-1", "api:latest")
+	evt.Labels = map[string]string{
+		"com.docker.compose.project": "shop",
+		"com.docker.compose.service": "api",
+		LabelRunbook:                 "https://wiki.example.com/runbooks/api",
+	}
+	evt.SetPayload(&event.ThresholdPayload{Metric: event.MetricCPU, Value: 95.5, Threshold: 90})
+	evt.Severity = event.SeverityCritical
+
+	slack, err := NewChatSink(ChatOptions{Format: FormatSlack, URL: srv.URL + "/slack", Token: "xoxb", Channel: "#ops", Threads: true})
+	if err != nil {
+		t.Fatalf("Failed to create slack sink: %v", err)
+	}
+	discord, err := NewChatSink(ChatOptions{Format: FormatDiscord, URL: srv.URL + "/discord", Threads: true})
+	if err != nil {
+		t.Fatalf("Failed to create discord sink: %v", err)
+	}
+	for _, sink := range []Sink{slack, slack, discord, discord} {
+		if err := sink.Send(context.Background(), evt); err != nil {
+			t.Fatalf("%s: send failed: %v", sink.Name(), err)
+		}
+	}
+
+	// Slack: the second message replies in the thread of the first
+	if _, ok := requests[0]["thread_ts"]; ok {
+		t.Error("Expected first slack message to start a thread")
+	}
+	if requests[1]["thread_ts"] != "1700000000.000100" {
+		t.Errorf("Expected reply in thread, got %v", requests[1]["thread_ts"])
+	}
+	att := requests[0]["attachments"].([]interface{})[0].(map[string]interface{})
+	if att["color"] != "#D32F2F" || att["title_link"] != "https://wiki.example.com/runbooks/api" {
+		t.Errorf("Unexpected slack attachment: %v", att)
+	}
+	fields, _ := json.Marshal(att["fields"])
+	if !strings.Contains(string(fields), "CPU 95.5% (limit 90.0%)") || !strings.Contains(string(fields), "shop/api") {
+		t.Errorf("Expected value and compose fields, got %s", fields)
+	}
+
+	// Discord: the first message creates a forum post named after the service
+	if requests[2]["thread_name"] != "shop/api" || queries[2] != "wait=true" {
+		t.Errorf("Expected new thread, got %v (%s)", requests[2]["thread_name"], queries[2])
+	}
+	if _, ok := requests[3]["thread_name"]; ok || queries[3] != "thread_id=42&wait=true" {
+		t.Errorf("Expected reply in thread 42, got query %s", queries[3])
+	}
+
+	if _, err := NewChatSink(ChatOptions{Format: FormatTeams, URL: srv.URL, Threads: true}); err == nil {
+		t.Error("Expected error for teams threads")
+	}
+	if _, err := NewChatSink(ChatOptions{Format: FormatSlack, URL: srv.URL, Threads: true}); err == nil {
+		t.Error("Expected error for slack threads without a bot token")
+	}
+}
+
+// fakeDesktop records notifications and lets the test invoke actions
+type fakeDesktop struct {
+	mu            sync.Mutex
+	notifications []DesktopNotification
+	actions       chan ActionInvoked
+}
+
+func (d *fakeDesktop) Notify(n DesktopNotification) (uint32, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.notifications = append(d.notifications, n)
+	return uint32(len(d.notifications)), nil
+}
+
+func (d *fakeDesktop) Actions() <-chan ActionInvoked { return d.actions }
+func (d *fakeDesktop) Close() error                  { return nil }
+
+func (d *fakeDesktop) count() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.notifications)
+}
+
+func TestDesktopSink(t *testing.T) {
+	backend := &fakeDesktop{actions: make(chan ActionInvoked)}
+	sink := NewDesktopSink(DesktopOptions{RateLimit: 2, Snooze: time.Hour}, backend)
+	defer sink.Close()
+
+	api := event.NewEvent(event.EventTypeDied, "abc", "api", "api:latest")
+	db := event.NewEvent(event.EventTypeDied, "def", "db", "postgres")
+	api.Severity = event.SeverityCritical
+	db.Severity = event.SeverityCritical
+	for _, evt := range []*event.Event{api, db, db, db} {
+		if err := sink.Send(context.Background(), evt); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+	}
+	if backend.count() != 2 {
+		t.Fatalf("Expected rate limit of 2 notifications, got %d", backend.count())
+	}
+	if n := backend.notifications[0]; n.Urgency != UrgencyCritical || n.Actions[0].Label != "Snooze api for 1h" {
+		t.Errorf("Unexpected notification: %+v", n)
+	}
+
+	// Snoozing api from its notification (ID 1) suppresses its events
+	backend.actions <- ActionInvoked{ID: 1, Key: actionSnooze}
+	backend.actions <- ActionInvoked{ID: 1, Key: "default"} // Wait for the snooze to be handled
+	sink.mu.Lock()
+	sink.sent = nil
+	sink.mu.Unlock()
+	for _, evt := range []*event.Event{api, db} {
+		if err := sink.Send(context.Background(), evt); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+	}
+	if backend.count() != 3 {
+		t.Fatalf("Expected only the db notification after snoozing api, got %d", backend.count())
+	}
+	if body := backend.notifications[2].Body; !strings.HasSuffix(body, "(2 more events suppressed)") {
+		t.Errorf("Expected suppressed count in body, got %q", body)
+	}
+}
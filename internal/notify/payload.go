@@ -0,0 +1,108 @@
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"docksphinx/internal/event"
+)
+
+// Payload is the JSON representation of an event sent to sinks and written to the dead-letter file
+type Payload struct {
+	ID            string                 `json:"id"`
+	Type          string                 `json:"type"`
+	Severity      string                 `json:"severity"`
+	Timestamp     time.Time              `json:"timestamp"`
+	ContainerID   string                 `json:"container_id"`
+	ContainerName string                 `json:"container_name"`
+	ImageName     string                 `json:"image_name"`
+	Labels        map[string]string      `json:"labels,omitempty"`
+	Message       string                 `json:"message"`
+	Data          map[string]interface{} `json:"data,omitempty"`
+	Logs          []LogLine              `json:"logs,omitempty"`
+}
+
+// LogLine is a captured container log line of a payload
+type LogLine struct {
+	Stream    string    `json:"stream"`
+	Timestamp time.Time `json:"timestamp"`
+	Text      string    `json:"text"`
+}
+
+// NewPayload converts an event to its payload
+func NewPayload(evt *event.Event) Payload {
+	p := Payload{
+		ID:            evt.ID,
+		Type:          string(evt.Type),
+		Severity:      evt.Severity.String(),
+		Timestamp:     evt.Timestamp,
+		ContainerID:   evt.ContainerID,
+		ContainerName: evt.ContainerName,
+		ImageName:     evt.ImageName,
+		Labels:        evt.Labels,
+		Message:       evt.Message,
+		Data:          evt.Data,
+	}
+	for _, l := range evt.Logs {
+		p.Logs = append(p.Logs, LogLine{Stream: l.Stream, Timestamp: l.Timestamp, Text: l.Text})
+	}
+	return p
+}
+
+// DeadLetter appends undeliverable events to a JSON lines file
+type DeadLetter struct {
+	mu   sync.Mutex
+	path string
+}
+
+// deadLetterRecord is a line of the dead-letter file
+type deadLetterRecord struct {
+	Time  time.Time `json:"time"`
+	Sink  string    `json:"sink"`
+	Error string    `json:"error"`
+	Event Payload   `json:"event"`
+}
+
+// NewDeadLetter creates a dead-letter file writer (the file is created on the first write)
+func NewDeadLetter(path string) *DeadLetter {
+	return &DeadLetter{path: path}
+}
+
+// Path returns the dead-letter file path
+func (d *DeadLetter) Path() string {
+	return d.path
+}
+
+// Write appends an event that could not be delivered to sink
+func (d *DeadLetter) Write(sink string, evt *event.Event, cause error) error {
+	data, err := json.Marshal(deadLetterRecord{
+		Time:  time.Now(),
+		Sink:  sink,
+		Error: cause.Error(),
+		Event: NewPayload(evt),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal dead letter: %w", err)
+	}
+	data = append(data, '\n')
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(d.path), 0o700); err != nil {
+		return fmt.Errorf("create dead-letter directory: %w", err)
+	}
+	f, err := os.OpenFile(d.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("open dead-letter file: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		return fmt.Errorf("write dead-letter file: %w", err)
+	}
+	return nil
+}
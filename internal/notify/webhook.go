@@ -0,0 +1,122 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"docksphinx/internal/event"
+)
+
+// WebhookOptions configures a webhook sink
+type WebhookOptions struct {
+	Name    string            // Used in logs and dead letters (default: the URL host)
+	URL     string            // http(s) URL the events are POSTed to
+	Headers map[string]string // Extra request headers, e.g. for authentication
+	Timeout time.Duration     // Request timeout (default: 10s)
+
+	// Go text/template rendering the request body from a Payload.
+	// Empty sends the payload as JSON. The json function encodes a value, e.g. {{json .Message}}.
+	BodyTemplate string
+}
+
+// WebhookSink POSTs events as JSON to an HTTP endpoint
+type WebhookSink struct {
+	opts     WebhookOptions
+	template *template.Template // nil: payload as JSON
+	client   *http.Client
+}
+
+// NewWebhookSink creates a webhook sink
+func NewWebhookSink(opts WebhookOptions) (*WebhookSink, error) {
+	if !strings.HasPrefix(opts.URL, "http://") && !strings.HasPrefix(opts.URL, "https://") {
+		return nil, fmt.Errorf("webhook url must be an http(s) URL: %q", opts.URL)
+	}
+	if opts.Name == "" {
+		opts.Name = opts.URL
+		if i := strings.Index(opts.URL, "://"); i >= 0 {
+			opts.Name = strings.SplitN(opts.URL[i+3:], "/", 2)[0]
+		}
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+
+	s := &WebhookSink{opts: opts, client: &http.Client{Timeout: opts.Timeout}}
+	if opts.BodyTemplate != "" {
+		tmpl, err := template.New(opts.Name).Funcs(template.FuncMap{"json": toJSON}).Parse(opts.BodyTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("parse body template: %w", err)
+		}
+		s.template = tmpl
+	}
+	return s, nil
+}
+
+// Name implements Sink
+func (s *WebhookSink) Name() string {
+	return s.opts.Name
+}
+
+// Send implements Sink. 4xx responses (except 408 and 429) are permanent errors.
+func (s *WebhookSink) Send(ctx context.Context, evt *event.Event) error {
+	body, err := s.body(evt)
+	if err != nil {
+		return &PermanentError{Err: err}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.opts.URL, bytes.NewReader(body))
+	if err != nil {
+		return &PermanentError{Err: err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "docksphinx")
+	for k, v := range s.opts.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+		return nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	err = fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	if resp.StatusCode/100 == 4 && resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+		return &PermanentError{Err: err}
+	}
+	return err
+}
+
+// body renders the request body of an event
+func (s *WebhookSink) body(evt *event.Event) ([]byte, error) {
+	payload := NewPayload(evt)
+	if s.template == nil {
+		return json.Marshal(payload)
+	}
+	var buf bytes.Buffer
+	if err := s.template.Execute(&buf, payload); err != nil {
+		return nil, fmt.Errorf("render body template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// toJSON encodes a value for use in body templates
+func toJSON(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
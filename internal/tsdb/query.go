@@ -0,0 +1,224 @@
+package tsdb
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+)
+
+// MaxPoints bounds the number of points per series returned by a query
+const MaxPoints = 11000
+
+// Query selects stored metrics
+type Query struct {
+	ContainerID string    // Container ID or name (empty matches all)
+	Metrics     []Metric  // Empty selects all metrics
+	Start       time.Time // Required
+	End         time.Time // Zero means now
+
+	// Width of the returned points. 0 uses the resolution of the selected tier
+	// (individual samples for raw data).
+	Step time.Duration
+}
+
+// Result is the result of a query
+type Result struct {
+	Resolution string        // Tier the data was read from: raw, 1m, 5m or 1h
+	Step       time.Duration // Width of the points (0 for raw samples)
+	Series     []Series      // Sorted by container name and metric
+}
+
+// seriesKey identifies an aggregated point while reading
+type seriesKey struct {
+	id     string
+	metric Metric
+}
+
+// Query reads metrics from the finest resolution that covers q.Start and is not finer than q.Step
+func (s *Store) Query(q Query) (*Result, error) {
+	now := time.Now()
+	if q.End.IsZero() {
+		q.End = now
+	}
+	if q.Start.IsZero() || !q.Start.Before(q.End) {
+		return nil, fmt.Errorf("invalid time range")
+	}
+	if q.Step < 0 {
+		return nil, fmt.Errorf("step must not be negative")
+	}
+	metrics := q.Metrics
+	if len(metrics) == 0 {
+		for m := Metric(0); m < NumMetrics; m++ {
+			metrics = append(metrics, m)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, fmt.Errorf("metric store is closed")
+	}
+
+	t := s.selectTier(q.Start, q.Step, now)
+	step := max(q.Step, t.step)
+	if step > 0 && q.End.Sub(q.Start)/step > MaxPoints {
+		return nil, fmt.Errorf("too many points (more than %d); increase the step", MaxPoints)
+	}
+	if err := t.flush(); err != nil {
+		return nil, err
+	}
+
+	points := make(map[seriesKey]map[int64]*Point)
+	names := make(map[string]string)
+	add := func(rec record) {
+		if q.ContainerID != "" && rec.ID != q.ContainerID && rec.Name != q.ContainerID {
+			return
+		}
+		at := time.Unix(rec.T, 0)
+		if at.Before(q.Start.Truncate(max(t.step, time.Second))) || at.After(q.End) {
+			return
+		}
+		if rec.Name != "" {
+			names[rec.ID] = rec.Name
+		}
+		bucketAt := at
+		if step > 0 {
+			bucketAt = at.Truncate(step)
+		}
+		for _, m := range metrics {
+			if int(m) >= len(rec.Avg) {
+				continue
+			}
+			key := seriesKey{id: rec.ID, metric: m}
+			if points[key] == nil {
+				points[key] = make(map[int64]*Point)
+			}
+			merge(points[key], bucketAt, rec, m)
+		}
+	}
+
+	segs, err := t.segments()
+	if err != nil {
+		return nil, err
+	}
+	for _, seg := range segs {
+		if !seg.start.Before(q.End) || !seg.start.Add(t.segment).After(q.Start) {
+			continue
+		}
+		if err := readSegment(seg.path, add); err != nil {
+			return nil, err
+		}
+	}
+	// Include the steps still being aggregated
+	for _, b := range t.buckets {
+		add(b.record())
+	}
+
+	return &Result{Resolution: t.name, Step: step, Series: buildSeries(points, names)}, nil
+}
+
+// selectTier picks the coarsest tier covering start that is not coarser than step,
+// falling back to the finest tier covering start, or the tier with the longest retention.
+func (s *Store) selectTier(start time.Time, step time.Duration, now time.Time) *tier {
+	var finestCovering, best *tier
+	for _, t := range s.tiers {
+		if start.Before(now.Add(-t.retention)) {
+			continue
+		}
+		if finestCovering == nil {
+			finestCovering = t
+		}
+		if t.step <= step {
+			best = t
+		}
+	}
+	switch {
+	case best != nil:
+		return best
+	case finestCovering != nil:
+		return finestCovering
+	default:
+		longest := s.tiers[0]
+		for _, t := range s.tiers {
+			if t.retention > longest.retention {
+				longest = t
+			}
+		}
+		return longest
+	}
+}
+
+// merge adds the value of metric m in rec to the point at bucketAt
+func merge(points map[int64]*Point, bucketAt time.Time, rec record, m Metric) {
+	avg := rec.Avg[m]
+	minValue, maxValue := avg, avg
+	if int(m) < len(rec.Min) && int(m) < len(rec.Max) {
+		minValue, maxValue = rec.Min[m], rec.Max[m]
+	}
+	count := max(rec.Count, 1)
+
+	p := points[bucketAt.Unix()]
+	if p == nil {
+		points[bucketAt.Unix()] = &Point{Time: bucketAt, Min: minValue, Max: maxValue, Avg: avg, Count: count}
+		return
+	}
+	p.Min = min(p.Min, minValue)
+	p.Max = max(p.Max, maxValue)
+	p.Avg = (p.Avg*float64(p.Count) + avg*float64(count)) / float64(p.Count+count)
+	p.Count += count
+}
+
+// readSegment calls fn for every record of a segment file. Malformed lines (e.g. a partial
+// last line after a crash) are skipped.
+func readSegment(path string, fn func(record)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("open segment: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var rec record
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			continue
+		}
+		fn(rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read segment: %w", err)
+	}
+	return nil
+}
+
+// buildSeries sorts the aggregated points into series
+func buildSeries(points map[seriesKey]map[int64]*Point, names map[string]string) []Series {
+	series := make([]Series, 0, len(points))
+	for key, byTime := range points {
+		s := Series{ContainerID: key.id, ContainerName: names[key.id], Metric: key.metric}
+		for _, p := range byTime {
+			s.Points = append(s.Points, *p)
+		}
+		sort.Slice(s.Points, func(i, j int) bool { return s.Points[i].Time.Before(s.Points[j].Time) })
+		series = append(series, s)
+	}
+	sort.Slice(series, func(i, j int) bool {
+		a, b := series[i], series[j]
+		if a.ContainerName != b.ContainerName {
+			return a.ContainerName < b.ContainerName
+		}
+		if a.ContainerID != b.ContainerID {
+			return a.ContainerID < b.ContainerID
+		}
+		return a.Metric < b.Metric
+	})
+	return series
+}
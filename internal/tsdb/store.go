@@ -0,0 +1,374 @@
+package tsdb
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// compactInterval bounds how often retention is enforced while appending
+const compactInterval = time.Minute
+
+// segmentExt is the file extension of segment files
+const segmentExt = ".jsonl"
+
+// Store is an embedded time-series store. It is safe for concurrent use.
+type Store struct {
+	mu          sync.Mutex
+	tiers       []*tier // Finest first
+	maxSize     int64
+	lastCompact time.Time
+	closed      bool
+}
+
+// tier holds the data of a single resolution
+type tier struct {
+	name      string
+	step      time.Duration // 0 for raw samples
+	retention time.Duration
+	segment   time.Duration // Time span of a segment file
+	dir       string
+
+	// Segment file currently appended to
+	file      *os.File
+	w         *bufio.Writer
+	fileStart time.Time
+
+	// Partial aggregates of the current step per container (downsampled tiers only)
+	buckets map[string]*bucket
+}
+
+// bucket aggregates the samples of a container within a step
+type bucket struct {
+	start time.Time
+	id    string
+	name  string
+	count int
+	min   [NumMetrics]float64
+	max   [NumMetrics]float64
+	sum   [NumMetrics]float64
+}
+
+// record is a line of a segment file. Min and Max are omitted for raw samples.
+type record struct {
+	T     int64     `json:"t"`
+	ID    string    `json:"id"`
+	Name  string    `json:"name,omitempty"`
+	Count int       `json:"n"`
+	Avg   []float64 `json:"avg"`
+	Min   []float64 `json:"min,omitempty"`
+	Max   []float64 `json:"max,omitempty"`
+}
+
+// Open opens (creating if needed) a store in cfg.Dir and removes expired data
+func Open(cfg Config) (*Store, error) {
+	if cfg.Dir == "" {
+		return nil, fmt.Errorf("metric store directory is not set")
+	}
+	tiers := []*tier{
+		{name: "raw", retention: orDefault(cfg.RawRetention, DefaultRawRetention), segment: time.Hour},
+		{name: "1m", step: time.Minute, retention: orDefault(cfg.Retention1m, Default1mRetention), segment: 6 * time.Hour},
+		{name: "5m", step: 5 * time.Minute, retention: orDefault(cfg.Retention5m, Default5mRetention), segment: 24 * time.Hour},
+		{name: "1h", step: time.Hour, retention: orDefault(cfg.Retention1h, Default1hRetention), segment: 7 * 24 * time.Hour},
+	}
+	for _, t := range tiers {
+		t.dir = filepath.Join(cfg.Dir, t.name)
+		t.buckets = make(map[string]*bucket)
+		if err := os.MkdirAll(t.dir, 0o755); err != nil {
+			return nil, fmt.Errorf("create metric store directory: %w", err)
+		}
+	}
+
+	s := &Store{tiers: tiers, maxSize: cfg.MaxSize}
+	if err := s.compact(time.Now()); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func orDefault(d, def time.Duration) time.Duration {
+	if d <= 0 {
+		return def
+	}
+	return d
+}
+
+// Append stores samples, typically all containers of a single collection
+func (s *Store) Append(samples []Sample) error {
+	if len(samples) == 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return fmt.Errorf("metric store is closed")
+	}
+
+	latest := samples[0].Time
+	for _, sample := range samples {
+		if sample.Time.After(latest) {
+			latest = sample.Time
+		}
+	}
+
+	for _, t := range s.tiers {
+		if err := t.append(samples, latest); err != nil {
+			return err
+		}
+	}
+
+	if latest.Sub(s.lastCompact) >= compactInterval {
+		return s.compact(latest)
+	}
+	return nil
+}
+
+// append writes raw samples or adds them to the current buckets.
+// Buckets whose step has ended by latest are written out.
+func (t *tier) append(samples []Sample, latest time.Time) error {
+	if t.step == 0 {
+		for _, sample := range samples {
+			values := sample.Values
+			if err := t.write(record{
+				T:     sample.Time.Unix(),
+				ID:    sample.ContainerID,
+				Name:  sample.ContainerName,
+				Count: 1,
+				Avg:   values[:],
+			}, sample.Time); err != nil {
+				return err
+			}
+		}
+		return t.flush()
+	}
+
+	for _, sample := range samples {
+		start := sample.Time.Truncate(t.step)
+		b := t.buckets[sample.ContainerID]
+		if b != nil && !b.start.Equal(start) {
+			if err := t.write(b.record(), b.start); err != nil {
+				return err
+			}
+			b = nil
+		}
+		if b == nil {
+			b = &bucket{start: start, id: sample.ContainerID}
+			t.buckets[sample.ContainerID] = b
+		}
+		b.add(sample)
+	}
+
+	// Write out buckets of containers that are no longer sampled
+	for id, b := range t.buckets {
+		if !b.start.Add(t.step).After(latest) {
+			if err := t.write(b.record(), b.start); err != nil {
+				return err
+			}
+			delete(t.buckets, id)
+		}
+	}
+	return t.flush()
+}
+
+// write appends a record to the segment file covering at
+func (t *tier) write(rec record, at time.Time) error {
+	start := at.Truncate(t.segment)
+	if t.file == nil || !t.fileStart.Equal(start) {
+		if err := t.closeFile(); err != nil {
+			return err
+		}
+		path := filepath.Join(t.dir, strconv.FormatInt(start.Unix(), 10)+segmentExt)
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return fmt.Errorf("open segment: %w", err)
+		}
+		t.file = f
+		t.w = bufio.NewWriter(f)
+		t.fileStart = start
+	}
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if _, err := t.w.Write(data); err != nil {
+		return fmt.Errorf("write segment: %w", err)
+	}
+	return nil
+}
+
+// flush flushes buffered records to the segment file
+func (t *tier) flush() error {
+	if t.w == nil {
+		return nil
+	}
+	if err := t.w.Flush(); err != nil {
+		return fmt.Errorf("write segment: %w", err)
+	}
+	return nil
+}
+
+// closeFile flushes and closes the current segment file
+func (t *tier) closeFile() error {
+	if t.file == nil {
+		return nil
+	}
+	err := t.flush()
+	if cerr := t.file.Close(); err == nil {
+		err = cerr
+	}
+	t.file, t.w = nil, nil
+	return err
+}
+
+func (b *bucket) add(sample Sample) {
+	if sample.ContainerName != "" {
+		b.name = sample.ContainerName
+	}
+	for i, v := range sample.Values {
+		if b.count == 0 || v < b.min[i] {
+			b.min[i] = v
+		}
+		if b.count == 0 || v > b.max[i] {
+			b.max[i] = v
+		}
+		b.sum[i] += v
+	}
+	b.count++
+}
+
+func (b *bucket) record() record {
+	rec := record{
+		T:     b.start.Unix(),
+		ID:    b.id,
+		Name:  b.name,
+		Count: b.count,
+		Avg:   make([]float64, NumMetrics),
+		Min:   append([]float64(nil), b.min[:]...),
+		Max:   append([]float64(nil), b.max[:]...),
+	}
+	for i := range rec.Avg {
+		rec.Avg[i] = b.sum[i] / float64(b.count)
+	}
+	return rec
+}
+
+// segmentFile is a segment file found on disk
+type segmentFile struct {
+	tier  *tier
+	path  string
+	start time.Time
+	size  int64
+}
+
+// segments lists the segment files of the tier, oldest first
+func (t *tier) segments() ([]segmentFile, error) {
+	entries, err := os.ReadDir(t.dir)
+	if err != nil {
+		return nil, fmt.Errorf("read metric store: %w", err)
+	}
+	var segs []segmentFile
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		unix, err := strconv.ParseInt(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		segs = append(segs, segmentFile{tier: t, path: filepath.Join(t.dir, name), start: time.Unix(unix, 0), size: info.Size()})
+	}
+	sort.Slice(segs, func(i, j int) bool { return segs[i].start.Before(segs[j].start) })
+	return segs, nil
+}
+
+// compact removes segments older than their retention, then the oldest segments until the size limit is met
+func (s *Store) compact(now time.Time) error {
+	s.lastCompact = now
+
+	var all []segmentFile
+	var total int64
+	for _, t := range s.tiers {
+		segs, err := t.segments()
+		if err != nil {
+			return err
+		}
+		for _, seg := range segs {
+			if seg.start.Add(t.segment).Before(now.Add(-t.retention)) {
+				if err := s.removeSegment(seg); err != nil {
+					return err
+				}
+				continue
+			}
+			all = append(all, seg)
+			total += seg.size
+		}
+	}
+
+	if s.maxSize <= 0 || total <= s.maxSize {
+		return nil
+	}
+	sort.SliceStable(all, func(i, j int) bool { return all[i].start.Before(all[j].start) })
+	for _, seg := range all {
+		if total <= s.maxSize {
+			break
+		}
+		if err := s.removeSegment(seg); err != nil {
+			return err
+		}
+		total -= seg.size
+	}
+	return nil
+}
+
+// removeSegment deletes a segment file, closing it first if it is being appended to
+func (s *Store) removeSegment(seg segmentFile) error {
+	if seg.tier.file != nil && seg.tier.fileStart.Equal(seg.start) {
+		if err := seg.tier.closeFile(); err != nil {
+			return err
+		}
+	}
+	if err := os.Remove(seg.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove segment: %w", err)
+	}
+	return nil
+}
+
+// Close writes out partial aggregates and closes the store
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	var firstErr error
+	for _, t := range s.tiers {
+		for id, b := range t.buckets {
+			if err := t.write(b.record(), b.start); err != nil && firstErr == nil {
+				firstErr = err
+			}
+			delete(t.buckets, id)
+		}
+		if err := t.closeFile(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
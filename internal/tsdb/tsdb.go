@@ -0,0 +1,101 @@
+// Package tsdb implements an embedded time-series store for container metrics.
+//
+// Raw samples and downsampled aggregates (1m, 5m and 1h min/max/avg) are kept in
+// separate tiers on disk. Each tier is split into segment files of JSON lines,
+// which are deleted as a whole once they are older than the tier retention or
+// the store exceeds its size limit.
+package tsdb
+
+import (
+	"fmt"
+	"time"
+)
+
+// Metric identifies a stored container metric
+type Metric int
+
+const (
+	MetricCPUPercent Metric = iota
+	MetricMemoryUsage
+	MetricMemoryPercent
+	MetricNetworkRxRate
+	MetricNetworkTxRate
+	MetricBlockReadRate
+	MetricBlockWriteRate
+	NumMetrics // Number of metrics, not a metric
+)
+
+var metricNames = [NumMetrics]string{
+	"cpu_percent",
+	"memory_usage",
+	"memory_percent",
+	"network_rx_rate",
+	"network_tx_rate",
+	"block_read_rate",
+	"block_write_rate",
+}
+
+func (m Metric) String() string {
+	if m < 0 || m >= NumMetrics {
+		return fmt.Sprintf("metric(%d)", int(m))
+	}
+	return metricNames[m]
+}
+
+// ParseMetric returns the metric with the given name
+func ParseMetric(name string) (Metric, error) {
+	for i, n := range metricNames {
+		if n == name {
+			return Metric(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown metric %q", name)
+}
+
+// Sample is a single metric sample of a container
+type Sample struct {
+	Time          time.Time
+	ContainerID   string
+	ContainerName string
+	Values        [NumMetrics]float64
+}
+
+// Point is an aggregated value of a metric over a step (a single sample for raw data)
+type Point struct {
+	Time  time.Time // Start of the step
+	Min   float64
+	Max   float64
+	Avg   float64
+	Count int // Number of raw samples aggregated
+}
+
+// Series is the points of a metric of a container, oldest first
+type Series struct {
+	ContainerID   string
+	ContainerName string
+	Metric        Metric
+	Points        []Point
+}
+
+// Default retention of each resolution
+const (
+	DefaultRawRetention = 6 * time.Hour
+	Default1mRetention  = 24 * time.Hour
+	Default5mRetention  = 7 * 24 * time.Hour
+	Default1hRetention  = 30 * 24 * time.Hour
+)
+
+// Config represents time-series store configuration
+type Config struct {
+	Dir string // Data directory (required)
+
+	// Retention per resolution (0: default)
+	RawRetention time.Duration
+	Retention1m  time.Duration
+	Retention5m  time.Duration
+	Retention1h  time.Duration
+
+	// Upper bound of the total size in bytes (0: unlimited).
+	// The oldest segments of any resolution are deleted first.
+	MaxSize int64
+}
@@ -0,0 +1,102 @@
+package tsdb
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func sampleAt(at time.Time, cpu float64) Sample {
+	s := Sample{Time: at, ContainerID: "abc123", ContainerName: "api"}
+	s.Values[MetricCPUPercent] = cpu
+	s.Values[MetricMemoryUsage] = cpu * 1000
+	return s
+}
+
+func TestStoreQuery(t *testing.T) {
+	dir := t.TempDir()
+	store, err := Open(Config{Dir: dir})
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+
+	start := time.Now().Add(-10 * time.Minute).Truncate(time.Minute)
+	// Two minutes of samples every 10s: 10..60 in the first minute, 70..120 in the second
+	for i := 0; i < 12; i++ {
+		if err := store.Append([]Sample{sampleAt(start.Add(time.Duration(i)*10*time.Second), float64(i+1)*10)}); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+	}
+
+	raw, err := store.Query(Query{ContainerID: "api", Metrics: []Metric{MetricCPUPercent}, Start: start})
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if raw.Resolution != "raw" || len(raw.Series) != 1 || len(raw.Series[0].Points) != 12 {
+		t.Fatalf("Expected 12 raw points, got %+v", raw)
+	}
+
+	perMinute, err := store.Query(Query{ContainerID: "abc123", Metrics: []Metric{MetricCPUPercent}, Start: start, Step: time.Minute})
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if perMinute.Resolution != "1m" || len(perMinute.Series) != 1 {
+		t.Fatalf("Expected 1m series, got %+v", perMinute)
+	}
+	points := perMinute.Series[0].Points
+	if len(points) != 2 {
+		t.Fatalf("Expected 2 points, got %+v", points)
+	}
+	if points[0].Min != 10 || points[0].Max != 60 || points[0].Avg != 35 || points[0].Count != 6 {
+		t.Errorf("Unexpected first minute: %+v", points[0])
+	}
+	// The second minute is still being aggregated in memory
+	if points[1].Min != 70 || points[1].Max != 120 {
+		t.Errorf("Unexpected second minute: %+v", points[1])
+	}
+
+	// Partial aggregates are written on close and merged after reopening
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	store, err = Open(Config{Dir: dir})
+	if err != nil {
+		t.Fatalf("Failed to reopen store: %v", err)
+	}
+	defer store.Close()
+	if err := store.Append([]Sample{sampleAt(start.Add(115*time.Second), 200)}); err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+	perMinute, err = store.Query(Query{Metrics: []Metric{MetricCPUPercent}, Start: start, Step: time.Minute})
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	second := perMinute.Series[0].Points[1]
+	if second.Count != 7 || second.Max != 200 {
+		t.Errorf("Expected merged second minute with 7 samples and max 200, got %+v", second)
+	}
+}
+
+func TestStoreRetention(t *testing.T) {
+	dir := t.TempDir()
+	old := time.Now().Add(-48 * time.Hour).Truncate(time.Hour)
+	path := filepath.Join(dir, "raw", strconv.FormatInt(old.Unix(), 10)+segmentExt)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("{}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := Open(Config{Dir: dir, RawRetention: time.Hour})
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	defer store.Close()
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected expired segment to be removed, got %v", err)
+	}
+}
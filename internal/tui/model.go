@@ -0,0 +1,445 @@
+package tui
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	pb "docksphinx/api/docksphinx/v1"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxEvents is the number of recent events kept by the TUI
+const maxEvents = 500
+
+// pane identifies a focusable pane
+type pane int
+
+const (
+	paneTargets pane = iota
+	paneList
+	paneDetail
+	paneCount
+)
+
+// target is the resource kind shown in the list pane
+type target int
+
+const (
+	targetContainers target = iota
+	targetImages
+	targetNetworks
+	targetVolumes
+	targetCount
+)
+
+func (t target) String() string {
+	switch t {
+	case targetContainers:
+		return "Containers"
+	case targetImages:
+		return "Images"
+	case targetNetworks:
+		return "Networks"
+	case targetVolumes:
+		return "Volumes"
+	default:
+		return "Unknown"
+	}
+}
+
+// sortKey is the list sort order
+type sortKey int
+
+const (
+	sortName sortKey = iota
+	sortCPU
+	sortRSS
+	sortGrowth
+	sortKeyCount
+)
+
+func (k sortKey) String() string {
+	switch k {
+	case sortCPU:
+		return "cpu"
+	case sortRSS:
+		return "rss"
+	case sortGrowth:
+		return "growth"
+	default:
+		return "name"
+	}
+}
+
+// Messages sent by the stream receiver
+type (
+	snapshotMsg struct{ snapshot *pb.Snapshot }
+	eventMsg    struct{ event *pb.Event }
+	historyMsg  struct{ histories []*pb.ContainerMetricHistory }
+	// connMsg reports the connection state; err is nil once the stream is established
+	connMsg struct{ err error }
+)
+
+// row is a single entry of the list pane
+type row struct {
+	id     string
+	name   string
+	line   string
+	cpu    float64
+	rss    int64
+	growth int64
+	size   int64
+}
+
+// Model is the bubbletea model of the TUI
+type Model struct {
+	address string
+	width   int
+	height  int
+
+	focus        pane
+	target       target
+	cursor       int
+	detailOffset int
+
+	snapshot  *pb.Snapshot
+	updatedAt time.Time
+	prevRSS   map[string]int64              // Memory usage per container in the previous snapshot
+	growth    map[string]int64              // Memory growth per container since the previous snapshot
+	events    []*pb.Event                   // Oldest first
+	histories map[string][]*pb.MetricSample // Recent metric samples per container, oldest first
+
+	// Updates received while paused are applied on resume
+	paused          bool
+	pendingSnapshot *pb.Snapshot
+	pendingHistory  []*pb.ContainerMetricHistory
+	pendingEvents   []*pb.Event
+
+	filter      string
+	filterInput string
+	filtering   bool
+	sortKey     sortKey
+
+	connected bool
+	connErr   error
+}
+
+// NewModel creates a TUI model for the daemon at address
+func NewModel(address string) Model {
+	return Model{
+		address:   address,
+		focus:     paneList,
+		prevRSS:   make(map[string]int64),
+		growth:    make(map[string]int64),
+		histories: make(map[string][]*pb.MetricSample),
+	}
+}
+
+// Init implements tea.Model
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	case snapshotMsg:
+		if m.paused {
+			m.pendingSnapshot = msg.snapshot
+		} else {
+			m.applySnapshot(msg.snapshot)
+		}
+	case historyMsg:
+		if m.paused {
+			m.pendingHistory = msg.histories
+		} else {
+			m.applyHistory(msg.histories)
+		}
+	case eventMsg:
+		if m.paused {
+			m.pendingEvents = appendEvent(m.pendingEvents, msg.event)
+		} else {
+			m.events = appendEvent(m.events, msg.event)
+		}
+	case connMsg:
+		m.connected = msg.err == nil
+		m.connErr = msg.err
+	}
+	return m, nil
+}
+
+// handleKey handles key presses
+func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.filtering {
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		case tea.KeyEnter:
+			m.filter = strings.TrimSpace(m.filterInput)
+			m.filtering = false
+			m.cursor = 0
+			m.detailOffset = 0
+		case tea.KeyEsc:
+			m.filtering = false
+		case tea.KeyBackspace:
+			if r := []rune(m.filterInput); len(r) > 0 {
+				m.filterInput = string(r[:len(r)-1])
+			}
+		case tea.KeyRunes, tea.KeySpace:
+			m.filterInput += string(msg.Runes)
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "tab":
+		m.focus = (m.focus + 1) % paneCount
+	case "shift+tab":
+		m.focus = (m.focus + paneCount - 1) % paneCount
+	case "right", "l":
+		if m.focus < paneDetail {
+			m.focus++
+		}
+	case "left", "h":
+		if m.focus > paneTargets {
+			m.focus--
+		}
+	case "down", "j":
+		m.move(1)
+	case "up", "k":
+		m.move(-1)
+	case "/":
+		m.filtering = true
+		m.filterInput = m.filter
+	case "esc":
+		m.filter = ""
+		m.cursor = 0
+	case "s":
+		m.sortKey = (m.sortKey + 1) % sortKeyCount
+	case "p", " ":
+		m.togglePause()
+	}
+	return m, nil
+}
+
+// move moves the selection of the focused pane by delta
+func (m *Model) move(delta int) {
+	switch m.focus {
+	case paneTargets:
+		t := m.target + target(delta)
+		if t >= 0 && t < targetCount {
+			m.target = t
+			m.cursor = 0
+			m.detailOffset = 0
+		}
+	case paneList:
+		m.cursor += delta
+		m.clampCursor()
+		m.detailOffset = 0
+	case paneDetail:
+		m.detailOffset = max(m.detailOffset+delta, 0)
+	}
+}
+
+// togglePause pauses updates, or resumes and applies the updates received while paused
+func (m *Model) togglePause() {
+	m.paused = !m.paused
+	if m.paused {
+		return
+	}
+	if m.pendingSnapshot != nil {
+		m.applySnapshot(m.pendingSnapshot)
+		m.pendingSnapshot = nil
+	}
+	if m.pendingHistory != nil {
+		m.applyHistory(m.pendingHistory)
+		m.pendingHistory = nil
+	}
+	for _, ev := range m.pendingEvents {
+		m.events = appendEvent(m.events, ev)
+	}
+	m.pendingEvents = nil
+}
+
+// applySnapshot replaces the current snapshot and updates memory growth
+func (m *Model) applySnapshot(s *pb.Snapshot) {
+	growth := make(map[string]int64, len(s.GetMetrics()))
+	prevRSS := make(map[string]int64, len(s.GetMetrics()))
+	for id, metrics := range s.GetMetrics() {
+		if prev, ok := m.prevRSS[id]; ok {
+			growth[id] = metrics.GetMemoryUsage() - prev
+		}
+		prevRSS[id] = metrics.GetMemoryUsage()
+	}
+	m.snapshot = s
+	m.updatedAt = time.Now()
+	m.growth = growth
+	m.prevRSS = prevRSS
+	m.clampCursor()
+}
+
+// applyHistory replaces the metric samples of all containers
+func (m *Model) applyHistory(histories []*pb.ContainerMetricHistory) {
+	m.histories = make(map[string][]*pb.MetricSample, len(histories))
+	for _, h := range histories {
+		m.histories[h.GetContainerId()] = h.GetSamples()
+	}
+}
+
+// trend returns a metric of the recent samples of a container, oldest first
+func (m Model) trend(id string, metric func(*pb.MetricSample) float64) []float64 {
+	samples := m.histories[id]
+	values := make([]float64, 0, len(samples))
+	for _, sample := range samples {
+		values = append(values, metric(sample))
+	}
+	return values
+}
+
+// clampCursor keeps the cursor within the list
+func (m *Model) clampCursor() {
+	n := len(m.rows())
+	if m.cursor >= n {
+		m.cursor = n - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+}
+
+// appendEvent appends ev, dropping the oldest events beyond maxEvents
+func appendEvent(events []*pb.Event, ev *pb.Event) []*pb.Event {
+	events = append(events, ev)
+	if len(events) > maxEvents {
+		events = append(events[:0:0], events[len(events)-maxEvents:]...)
+	}
+	return events
+}
+
+// matchFilter reports whether any of the fields contains the filter (case-insensitive)
+func (m Model) matchFilter(fields ...string) bool {
+	if m.filter == "" {
+		return true
+	}
+	f := strings.ToLower(m.filter)
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), f) {
+			return true
+		}
+	}
+	return false
+}
+
+// rows returns the filtered and sorted entries of the selected target
+func (m Model) rows() []row {
+	s := m.snapshot
+	if s == nil {
+		return nil
+	}
+
+	var rows []row
+	switch m.target {
+	case targetContainers:
+		for _, c := range s.GetContainers() {
+			if !m.matchFilter(c.GetContainerName(), c.GetImageName()) {
+				continue
+			}
+			metrics := s.GetMetrics()[c.GetContainerId()]
+			growth, hasGrowth := m.growth[c.GetContainerId()]
+			r := row{
+				id:     c.GetContainerId(),
+				name:   c.GetContainerName(),
+				cpu:    metrics.GetCpuPercent(),
+				rss:    metrics.GetMemoryUsage(),
+				growth: growth,
+			}
+			growthText := "-"
+			if hasGrowth {
+				growthText = formatDelta(growth)
+			}
+			r.line = columns(r.name, c.GetState(), formatPercent(r.cpu), formatBytes(r.rss), growthText) +
+				" " + Sparkline(m.trend(r.id, (*pb.MetricSample).GetCpuPercent), trendWidth)
+			rows = append(rows, r)
+		}
+	case targetImages:
+		for _, img := range s.GetImages() {
+			name := img.GetRepository() + ":" + img.GetTag()
+			if !m.matchFilter(name, img.GetId()) {
+				continue
+			}
+			rows = append(rows, row{
+				id:   img.GetId(),
+				name: name,
+				size: img.GetSize(),
+				line: columns(name, shortID(strings.TrimPrefix(img.GetId(), "sha256:")), "", formatBytes(img.GetSize()), ""),
+			})
+		}
+	case targetNetworks:
+		for _, n := range s.GetNetworks() {
+			if !m.matchFilter(n.GetName(), n.GetDriver()) {
+				continue
+			}
+			rows = append(rows, row{
+				id:   n.GetId(),
+				name: n.GetName(),
+				line: columns(n.GetName(), n.GetDriver(), "", "", n.GetScope()),
+			})
+		}
+	case targetVolumes:
+		for _, v := range s.GetVolumes() {
+			if !m.matchFilter(v.GetName(), v.GetDriver()) {
+				continue
+			}
+			rows = append(rows, row{
+				id:   v.GetName(),
+				name: v.GetName(),
+				size: v.GetSize(),
+				line: columns(v.GetName(), v.GetDriver(), "", formatBytes(v.GetSize()), formatCount(v.GetRefCount())),
+			})
+		}
+	}
+
+	sortRows(rows, m.sortKey)
+	return rows
+}
+
+// sortRows sorts rows by key. Images and volumes have no CPU or RSS, so they are sorted by size instead.
+func sortRows(rows []row, key sortKey) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		switch key {
+		case sortCPU:
+			if a.cpu != b.cpu {
+				return a.cpu > b.cpu
+			}
+		case sortRSS:
+			if a.rss != b.rss {
+				return a.rss > b.rss
+			}
+		case sortGrowth:
+			if a.growth != b.growth {
+				return a.growth > b.growth
+			}
+		}
+		if key != sortName && a.size != b.size {
+			return a.size > b.size
+		}
+		return a.name < b.name
+	})
+}
+
+// selected returns the selected row
+func (m Model) selected() (row, bool) {
+	rows := m.rows()
+	if m.cursor < 0 || m.cursor >= len(rows) {
+		return row{}, false
+	}
+	return rows[m.cursor], true
+}
@@ -0,0 +1,97 @@
+package tui
+
+import (
+	"testing"
+
+	pb "docksphinx/api/docksphinx/v1"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func testSnapshot(rss map[string]int64) *pb.Snapshot {
+	s := &pb.Snapshot{Metrics: make(map[string]*pb.ContainerMetrics)}
+	cpu := map[string]float64{"web": 10, "db": 50, "cache": 5}
+	for _, name := range []string{"web", "db", "cache"} {
+		s.Containers = append(s.Containers, &pb.ContainerInfo{ContainerId: name + "-id", ContainerName: name, State: "running"})
+		s.Metrics[name+"-id"] = &pb.ContainerMetrics{ContainerId: name + "-id", CpuPercent: cpu[name], MemoryUsage: rss[name]}
+	}
+	return s
+}
+
+func rowNames(rows []row) []string {
+	names := make([]string, 0, len(rows))
+	for _, r := range rows {
+		names = append(names, r.name)
+	}
+	return names
+}
+
+func TestModelSortAndFilter(t *testing.T) {
+	var model tea.Model = NewModel("test")
+	model, _ = model.Update(snapshotMsg{snapshot: testSnapshot(map[string]int64{"web": 100, "db": 300, "cache": 200})})
+	model, _ = model.Update(snapshotMsg{snapshot: testSnapshot(map[string]int64{"web": 1000, "db": 310, "cache": 150})})
+	m := model.(Model)
+
+	tests := []struct {
+		key  sortKey
+		want []string
+	}{
+		{sortName, []string{"cache", "db", "web"}},
+		{sortCPU, []string{"db", "web", "cache"}},
+		{sortRSS, []string{"web", "db", "cache"}},
+		{sortGrowth, []string{"web", "db", "cache"}},
+	}
+	for _, tt := range tests {
+		m.sortKey = tt.key
+		got := rowNames(m.rows())
+		if len(got) != len(tt.want) {
+			t.Fatalf("sort %s: got %v, want %v", tt.key, got, tt.want)
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("sort %s: got %v, want %v", tt.key, got, tt.want)
+				break
+			}
+		}
+	}
+
+	m.filter = "WE"
+	if got := rowNames(m.rows()); len(got) != 1 || got[0] != "web" {
+		t.Errorf("filter: got %v, want [web]", got)
+	}
+}
+
+func TestModelPause(t *testing.T) {
+	var model tea.Model = NewModel("test")
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	model, _ = model.Update(snapshotMsg{snapshot: testSnapshot(nil)})
+	model, _ = model.Update(eventMsg{event: &pb.Event{Id: "1", Type: "started"}})
+
+	m := model.(Model)
+	if m.snapshot != nil || len(m.events) != 0 {
+		t.Fatalf("updates applied while paused")
+	}
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	m = model.(Model)
+	if m.snapshot == nil || len(m.events) != 1 {
+		t.Errorf("updates not applied on resume: snapshot=%v events=%d", m.snapshot != nil, len(m.events))
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		values []float64
+		width  int
+		want   string
+	}{
+		{[]float64{0, 50, 100}, 3, "▁▄█"},
+		{[]float64{1, 2, 3, 4}, 2, "▆█"},
+		{[]float64{0, 0}, 4, "  ▁▁"},
+		{nil, 2, "  "},
+	}
+	for _, tt := range tests {
+		if got := Sparkline(tt.values, tt.width); got != tt.want {
+			t.Errorf("Sparkline(%v, %d) = %q, want %q", tt.values, tt.width, got, tt.want)
+		}
+	}
+}
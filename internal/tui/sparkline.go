@@ -0,0 +1,33 @@
+package tui
+
+import "strings"
+
+// sparkTicks are the bar characters of a sparkline, lowest first
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders the last width values as a bar chart scaled from 0 to the maximum value.
+// Shorter series are padded on the left so that sparklines of a column line up.
+func Sparkline(values []float64, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+
+	maxValue := 0.0
+	for _, v := range values {
+		maxValue = max(maxValue, v)
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Repeat(" ", width-len(values)))
+	for _, v := range values {
+		i := 0
+		if maxValue > 0 && v > 0 {
+			i = int(v / maxValue * float64(len(sparkTicks)-1))
+		}
+		b.WriteRune(sparkTicks[min(max(i, 0), len(sparkTicks)-1)])
+	}
+	return b.String()
+}
@@ -0,0 +1,114 @@
+// Package tui implements the interactive terminal UI of docksphinx.
+// It is driven by the Stream RPC of docksphinxd.
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	pb "docksphinx/api/docksphinx/v1"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	// DefaultRefreshInterval is the default interval of snapshots requested from the daemon
+	DefaultRefreshInterval = 2 * time.Second
+	// reconnectDelay is the wait before reopening a failed stream
+	reconnectDelay = 2 * time.Second
+)
+
+// Options configures the TUI
+type Options struct {
+	Address         string        // Daemon address, shown in the status bar
+	RefreshInterval time.Duration // Snapshot interval (default: DefaultRefreshInterval)
+}
+
+// Run runs the TUI until the user quits or ctx is canceled
+func Run(ctx context.Context, client pb.DocksphinxServiceClient, opts Options) error {
+	if opts.RefreshInterval <= 0 {
+		opts.RefreshInterval = DefaultRefreshInterval
+	}
+
+	p := tea.NewProgram(NewModel(opts.Address), tea.WithAltScreen(), tea.WithContext(ctx))
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go receive(streamCtx, client, opts.RefreshInterval, p.Send)
+
+	if _, err := p.Run(); err != nil {
+		if errors.Is(err, tea.ErrProgramKilled) && ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("run tui: %w", err)
+	}
+	return nil
+}
+
+// receive forwards stream updates to the TUI, reopening the stream after failures
+func receive(ctx context.Context, client pb.DocksphinxServiceClient, interval time.Duration, send func(tea.Msg)) {
+	for {
+		err := streamUpdates(ctx, client, interval, send)
+		if ctx.Err() != nil {
+			return
+		}
+		send(connMsg{err: err})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+// streamUpdates opens a stream with an initial snapshot and forwards updates until it fails
+func streamUpdates(ctx context.Context, client pb.DocksphinxServiceClient, interval time.Duration, send func(tea.Msg)) error {
+	seconds := max(int32(interval/time.Second), 1)
+	stream, err := client.Stream(ctx, &pb.StreamRequest{
+		IncludeInitialSnapshot:  true,
+		SnapshotIntervalSeconds: seconds,
+	})
+	if err != nil {
+		return err
+	}
+
+	connected := false
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return errors.New("stream closed by daemon")
+			}
+			return err
+		}
+		if !connected {
+			connected = true
+			send(connMsg{})
+		}
+		switch payload := update.GetPayload().(type) {
+		case *pb.StreamUpdate_Snapshot:
+			send(snapshotMsg{snapshot: payload.Snapshot})
+			// Sparklines stay empty if the history cannot be fetched; the stream itself is still usable
+			if histories, err := metricHistory(ctx, client); err == nil {
+				send(historyMsg{histories: histories})
+			}
+		case *pb.StreamUpdate_Event:
+			send(eventMsg{event: payload.Event})
+		}
+	}
+}
+
+// metricHistory fetches the recent metric samples of all containers
+func metricHistory(ctx context.Context, client pb.DocksphinxServiceClient) ([]*pb.ContainerMetricHistory, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := client.GetMetricHistory(ctx, &pb.GetMetricHistoryRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetHistories(), nil
+}
@@ -0,0 +1,487 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	pb "docksphinx/api/docksphinx/v1"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Layout
+const (
+	targetsWidth     = 20
+	detailWidth      = 40
+	minDetailedWidth = 110 // The detail pane is hidden on narrower terminals
+	trendWidth       = 16  // Width of the CPU sparkline in the container list
+	detailTrendWidth = 20  // Width of the sparklines in the detail pane
+)
+
+// Styles
+var (
+	borderStyle  = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("240"))
+	focusedStyle = borderStyle.BorderForeground(lipgloss.Color("63"))
+	titleStyle   = lipgloss.NewStyle().Bold(true)
+	headerStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+	cursorStyle  = lipgloss.NewStyle().Reverse(true)
+	activeStyle  = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("63"))
+	statusStyle  = lipgloss.NewStyle().Background(lipgloss.Color("236")).Foreground(lipgloss.Color("252"))
+	warningStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("3"))
+	errorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+	okStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("2"))
+	mutedStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+)
+
+// View implements tea.Model
+func (m Model) View() string {
+	if m.width == 0 || m.height == 0 {
+		return fmt.Sprintf("Connecting to %s...\n", m.address)
+	}
+
+	bodyHeight := max(m.height-1, 3)
+	rightWidth := detailWidth
+	if m.width < minDetailedWidth {
+		rightWidth = 0
+	}
+	centerWidth := max(m.width-targetsWidth-rightWidth, 10)
+	listHeight := bodyHeight * 3 / 5
+	eventsHeight := bodyHeight - listHeight
+
+	left := m.renderPane(m.targetLines(), targetsWidth, bodyHeight, m.focus == paneTargets)
+	center := lipgloss.JoinVertical(lipgloss.Left,
+		m.renderPane(m.listLines(centerWidth-2, listHeight-2), centerWidth, listHeight, m.focus == paneList),
+		m.renderPane(m.eventLines(), centerWidth, eventsHeight, false),
+	)
+	body := lipgloss.JoinHorizontal(lipgloss.Top, left, center)
+	if rightWidth > 0 {
+		right := m.renderPane(m.detailLines(), rightWidth, bodyHeight, m.focus == paneDetail)
+		body = lipgloss.JoinHorizontal(lipgloss.Top, body, right)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, body, m.statusBar())
+}
+
+// renderPane renders lines in a bordered box of the given outer size, cutting off what does not fit
+func (m Model) renderPane(lines []string, width, height int, focused bool) string {
+	innerWidth, innerHeight := max(width-2, 1), max(height-2, 1)
+	if len(lines) > innerHeight {
+		lines = lines[:innerHeight]
+	}
+	for i, line := range lines {
+		lines[i] = lipgloss.NewStyle().MaxWidth(innerWidth).Render(line)
+	}
+	style := borderStyle
+	if focused {
+		style = focusedStyle
+	}
+	return style.Width(innerWidth).Height(innerHeight).Render(strings.Join(lines, "\n"))
+}
+
+// targetLines renders the target pane
+func (m Model) targetLines() []string {
+	lines := []string{titleStyle.Render("Targets"), ""}
+	for t := targetContainers; t < targetCount; t++ {
+		label := "  " + t.String()
+		if n := m.targetCount(t); n >= 0 {
+			label += fmt.Sprintf(" (%d)", n)
+		}
+		if t == m.target {
+			label = activeStyle.Render("> " + strings.TrimPrefix(label, "  "))
+		}
+		lines = append(lines, label)
+	}
+	return lines
+}
+
+// targetCount returns the number of entries of t in the snapshot, or -1 without a snapshot
+func (m Model) targetCount(t target) int {
+	if m.snapshot == nil {
+		return -1
+	}
+	switch t {
+	case targetContainers:
+		return len(m.snapshot.GetContainers())
+	case targetImages:
+		return len(m.snapshot.GetImages())
+	case targetNetworks:
+		return len(m.snapshot.GetNetworks())
+	case targetVolumes:
+		return len(m.snapshot.GetVolumes())
+	default:
+		return 0
+	}
+}
+
+// listLines renders the list pane, scrolled so that the cursor is visible
+func (m Model) listLines(width, height int) []string {
+	rows := m.rows()
+	title := fmt.Sprintf("%s (%d)  sort: %s", m.target, len(rows), m.sortKey)
+	lines := []string{titleStyle.Render(title), headerStyle.Render(m.listHeader())}
+	if m.snapshot == nil {
+		return append(lines, mutedStyle.Render("waiting for snapshot..."))
+	}
+	if len(rows) == 0 {
+		return append(lines, mutedStyle.Render("no entries"))
+	}
+
+	visible := max(height-len(lines), 1)
+	offset := 0
+	if m.cursor >= visible {
+		offset = m.cursor - visible + 1
+	}
+	for i := offset; i < len(rows) && i < offset+visible; i++ {
+		line := rows[i].line
+		if i == m.cursor {
+			line = cursorStyle.Render(pad(line, width))
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// listHeader returns the column header of the selected target
+func (m Model) listHeader() string {
+	switch m.target {
+	case targetContainers:
+		return columns("NAME", "STATE", "CPU", "RSS", "GROWTH") + " CPU TREND"
+	case targetImages:
+		return columns("IMAGE", "ID", "", "SIZE", "")
+	case targetNetworks:
+		return columns("NAME", "DRIVER", "", "", "SCOPE")
+	case targetVolumes:
+		return columns("NAME", "DRIVER", "", "SIZE", "REFS")
+	default:
+		return ""
+	}
+}
+
+// eventLines renders recent events matching the filter, newest first
+func (m Model) eventLines() []string {
+	lines := []string{titleStyle.Render("Events")}
+	for i := len(m.events) - 1; i >= 0; i-- {
+		ev := m.events[i]
+		if !m.matchFilter(ev.GetContainerName(), ev.GetImageName()) {
+			continue
+		}
+		lines = append(lines, formatEvent(ev))
+	}
+	if len(lines) == 1 {
+		lines = append(lines, mutedStyle.Render("no events yet"))
+	}
+	return lines
+}
+
+// detailLines renders the detail pane for the selected entry
+func (m Model) detailLines() []string {
+	lines := []string{titleStyle.Render("Detail"), ""}
+	r, ok := m.selected()
+	if !ok {
+		return append(lines, mutedStyle.Render("nothing selected"))
+	}
+
+	var body []string
+	switch m.target {
+	case targetContainers:
+		body = m.containerDetail(r.id)
+	case targetImages:
+		body = m.imageDetail(r.id)
+	case targetNetworks:
+		body = m.networkDetail(r.id)
+	case targetVolumes:
+		body = m.volumeDetail(r.id)
+	}
+	if m.detailOffset < len(body) {
+		body = body[m.detailOffset:]
+	} else if len(body) > 0 {
+		body = body[len(body)-1:]
+	}
+	return append(lines, body...)
+}
+
+func (m Model) containerDetail(id string) []string {
+	var c *pb.ContainerInfo
+	for _, info := range m.snapshot.GetContainers() {
+		if info.GetContainerId() == id {
+			c = info
+			break
+		}
+	}
+	if c == nil {
+		return nil
+	}
+	metrics := m.snapshot.GetMetrics()[id]
+	lines := []string{
+		field("Name", c.GetContainerName()),
+		field("ID", shortID(id)),
+		field("Image", c.GetImageName()),
+		field("State", c.GetState()),
+		field("Status", c.GetStatus()),
+		"",
+		field("CPU", formatPercent(metrics.GetCpuPercent())),
+		field("Memory", fmt.Sprintf("%s / %s (%s)",
+			formatBytes(metrics.GetMemoryUsage()), formatBytes(metrics.GetMemoryLimit()), formatPercent(metrics.GetMemoryPercent()))),
+		field("Net RX/TX", formatBytes(metrics.GetNetworkRx())+" / "+formatBytes(metrics.GetNetworkTx())),
+	}
+	if growth, ok := m.growth[id]; ok {
+		lines = append(lines, field("Growth", formatDelta(growth)))
+	}
+	if metrics.GetSizeRw() > 0 {
+		lines = append(lines, field("Size RW", formatBytes(metrics.GetSizeRw())))
+	}
+
+	if samples := m.histories[id]; len(samples) > 0 {
+		last := samples[len(samples)-1]
+		lines = append(lines, "", titleStyle.Render(fmt.Sprintf("Trends (%d samples)", len(samples))),
+			m.trendField(id, "CPU", (*pb.MetricSample).GetCpuPercent, formatPercent(last.GetCpuPercent())),
+			m.trendField(id, "Memory", func(s *pb.MetricSample) float64 { return float64(s.GetMemoryUsage()) },
+				formatBytes(last.GetMemoryUsage())),
+			m.trendField(id, "Net RX", (*pb.MetricSample).GetNetworkRxRate, formatRate(last.GetNetworkRxRate())),
+			m.trendField(id, "Net TX", (*pb.MetricSample).GetNetworkTxRate, formatRate(last.GetNetworkTxRate())),
+			m.trendField(id, "Block R", (*pb.MetricSample).GetBlockReadRate, formatRate(last.GetBlockReadRate())),
+			m.trendField(id, "Block W", (*pb.MetricSample).GetBlockWriteRate, formatRate(last.GetBlockWriteRate())),
+		)
+	}
+
+	lines = append(lines, "", titleStyle.Render("Recent events"))
+	n := 0
+	for i := len(m.events) - 1; i >= 0; i-- {
+		ev := m.events[i]
+		if ev.GetContainerId() != id {
+			continue
+		}
+		lines = append(lines, formatEvent(ev))
+		if n++; n >= 20 {
+			break
+		}
+	}
+	if n == 0 {
+		lines = append(lines, mutedStyle.Render("none"))
+	}
+	return lines
+}
+
+func (m Model) imageDetail(id string) []string {
+	for _, img := range m.snapshot.GetImages() {
+		if img.GetId() != id {
+			continue
+		}
+		return []string{
+			field("Repository", img.GetRepository()),
+			field("Tag", img.GetTag()),
+			field("ID", shortID(strings.TrimPrefix(id, "sha256:"))),
+			field("Size", formatBytes(img.GetSize())),
+			field("Created", time.Unix(img.GetCreatedUnix(), 0).Format(time.DateTime)),
+		}
+	}
+	return nil
+}
+
+func (m Model) networkDetail(id string) []string {
+	for _, n := range m.snapshot.GetNetworks() {
+		if n.GetId() != id {
+			continue
+		}
+		lines := []string{
+			field("Name", n.GetName()),
+			field("ID", shortID(id)),
+			field("Driver", n.GetDriver()),
+			field("Scope", n.GetScope()),
+			field("Internal", fmt.Sprint(n.GetInternal())),
+		}
+		return append(lines, labelLines(n.GetLabels())...)
+	}
+	return nil
+}
+
+func (m Model) volumeDetail(name string) []string {
+	for _, v := range m.snapshot.GetVolumes() {
+		if v.GetName() != name {
+			continue
+		}
+		lines := []string{
+			field("Name", v.GetName()),
+			field("Driver", v.GetDriver()),
+			field("Mountpoint", v.GetMountpoint()),
+			field("Size", formatBytes(v.GetSize())),
+			field("Refs", formatCount(v.GetRefCount())),
+		}
+		return append(lines, labelLines(v.GetLabels())...)
+	}
+	return nil
+}
+
+// statusBar renders the bottom status and help bar
+func (m Model) statusBar() string {
+	var parts []string
+	switch {
+	case m.connected:
+		parts = append(parts, okStyle.Render("● ")+m.address)
+	case m.connErr != nil:
+		parts = append(parts, errorStyle.Render("○ ")+"reconnecting: "+m.connErr.Error())
+	default:
+		parts = append(parts, warningStyle.Render("○ ")+"connecting to "+m.address)
+	}
+	if m.paused {
+		parts = append(parts, warningStyle.Render(fmt.Sprintf("PAUSED (%d new events)", len(m.pendingEvents))))
+	} else if !m.updatedAt.IsZero() {
+		parts = append(parts, "updated "+m.updatedAt.Format(time.TimeOnly))
+	}
+
+	if m.filtering {
+		parts = append(parts, "filter: "+m.filterInput+"█", mutedStyle.Render("enter:apply esc:cancel"))
+	} else {
+		if m.filter != "" {
+			parts = append(parts, "filter: "+m.filter)
+		}
+		parts = append(parts, mutedStyle.Render("tab/←→:pane j/k:move /:filter s:sort p:pause q:quit"))
+	}
+	return statusStyle.Width(m.width).MaxWidth(m.width).Render(" " + strings.Join(parts, "  │  "))
+}
+
+// formatEvent formats an event as a single line colored by severity
+func formatEvent(ev *pb.Event) string {
+	severity := eventSeverity(ev)
+	name := ev.GetContainerName()
+	if name == "" {
+		name = "-"
+	}
+	line := fmt.Sprintf("%s %-8s %-12s %s: %s",
+		time.Unix(ev.GetTimestampUnix(), 0).Format(time.TimeOnly), severity, ev.GetType(), name, ev.GetMessage())
+	switch severity {
+	case "critical":
+		return errorStyle.Render(line)
+	case "warning":
+		return warningStyle.Render(line)
+	default:
+		return line
+	}
+}
+
+// eventSeverity returns the severity of an event: critical, warning or info
+func eventSeverity(ev *pb.Event) string {
+	switch ev.GetSeverity() {
+	case pb.Severity_SEVERITY_CRITICAL:
+		return "critical"
+	case pb.Severity_SEVERITY_WARNING:
+		return "warning"
+	case pb.Severity_SEVERITY_INFO:
+		return "info"
+	}
+	// Older daemons do not send a severity
+	if level := ev.GetData()["level"]; level != "" {
+		return level
+	}
+	switch ev.GetType() {
+	case "died", "impact":
+		return "critical"
+	case "stopped", "restarted":
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
+// columns formats the list columns
+func columns(name, kind, cpu, rss, extra string) string {
+	return fmt.Sprintf("%-22s %-9s %7s %9s %9s", truncate(name, 22), truncate(kind, 9), cpu, rss, extra)
+}
+
+// field formats a label and value of the detail pane
+func field(label, value string) string {
+	return headerStyle.Render(fmt.Sprintf("%-11s", label)) + value
+}
+
+// trendField formats a sparkline of a container metric followed by its latest value
+func (m Model) trendField(id, label string, metric func(*pb.MetricSample) float64, latest string) string {
+	return field(label, Sparkline(m.trend(id, metric), detailTrendWidth)+" "+latest)
+}
+
+// labelLines formats labels sorted by key
+func labelLines(labels map[string]string) []string {
+	if len(labels) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	lines := []string{"", titleStyle.Render("Labels")}
+	for _, k := range keys {
+		lines = append(lines, k+"="+labels[k])
+	}
+	return lines
+}
+
+// shortID shortens an ID for display
+func shortID(id string) string {
+	if len(id) > 12 {
+		return id[:12]
+	}
+	return id
+}
+
+// formatBytes formats a byte count in human-readable units
+func formatBytes(n int64) string {
+	if n < 0 {
+		return "-"
+	}
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// formatDelta formats a signed byte delta
+func formatDelta(n int64) string {
+	if n < 0 {
+		return "-" + formatBytes(-n)
+	}
+	return "+" + formatBytes(n)
+}
+
+// formatRate formats a byte rate
+func formatRate(bytesPerSecond float64) string {
+	return formatBytes(int64(bytesPerSecond)) + "/s"
+}
+
+// formatPercent formats a percentage
+func formatPercent(p float64) string {
+	return fmt.Sprintf("%.1f%%", p)
+}
+
+// formatCount formats a count, where negative means unknown
+func formatCount(n int64) string {
+	if n < 0 {
+		return "-"
+	}
+	return fmt.Sprint(n)
+}
+
+// truncate shortens s to at most n runes
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	if n <= 1 {
+		return string(r[:n])
+	}
+	return string(r[:n-1]) + "…"
+}
+
+// pad truncates or pads s with spaces to exactly n runes
+func pad(s string, n int) string {
+	s = truncate(s, n)
+	if l := len([]rune(s)); l < n {
+		s += strings.Repeat(" ", n-l)
+	}
+	return s
+}